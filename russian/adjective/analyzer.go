@@ -0,0 +1,122 @@
+package adjective
+
+import (
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Результат разбора словоформы прилагательного. Во множественном числе род не определяется.
+ */
+type Analysis struct {
+	Lemma  string
+	Case   cases.Case
+	Number number.Number
+	Gender gender.Gender
+}
+
+/**
+ * Окончания полных прилагательных во всех родах, числах и падежах.
+ * @var string[]
+ */
+var inflectionEndings = []string{
+	"ый", "ий", "ой", "ая", "яя", "ое", "ее", "ые", "ие",
+	"ого", "его", "ому", "ему", "ым", "им", "ом", "ем",
+	"ую", "юю", "ей", "ых", "их", "ыми", "ими",
+}
+
+var analysisCaseOrder = []cases.Case{cases.Imenit, cases.Rodit, cases.Dat, cases.Vinit, cases.Tvorit, cases.Predloj}
+
+/**
+ * Морфологический разбор словоформы прилагательного: новой - новый (родительный, ж. р.),
+ * синими - синий (творительный, мн. ч.). Начальная форма - мужской род единственного числа.
+ * Каждая предполагаемая начальная форма проверяется склонением.
+ * @param string $word
+ * @return array
+ */
+func Analyze(w str.Word) []Analysis {
+	w = w.Lower()
+	form := w.String()
+
+	result := []Analysis{}
+	seen := map[Analysis]struct{}{}
+	add := func(analysis Analysis) {
+		if _, has := seen[analysis]; !has {
+			seen[analysis] = struct{}{}
+			result = append(result, analysis)
+		}
+	}
+
+	for _, ending := range inflectionEndings {
+		endingLen := len([]rune(ending))
+		if w.Len() < endingLen+2 || !w.EndsWith(endingLen, ending) {
+			continue
+		}
+		stem := w.SliceWord(0, w.Len()-endingLen)
+
+		for _, lemmaEnding := range []string{"ый", "ий", "ой"} {
+			if !isLemmaSpelled(stem, lemmaEnding) {
+				continue
+			}
+			lemma := str.Word(stem.Concat(lemmaEnding))
+			for _, animateness := range []bool{false, true} {
+				for _, gendr := range []gender.Gender{gender.Male, gender.Female, gender.Neuter} {
+					forms, err := GetCases(lemma, animateness, gendr)
+					if err != nil {
+						continue
+					}
+					for _, cCase := range analysisCaseOrder {
+						if sameForm(forms[cCase], form) {
+							add(Analysis{Lemma: lemma.String(), Case: cCase, Number: number.Singular, Gender: gendr})
+						}
+					}
+				}
+
+				forms, err := GetPluralCases(lemma, animateness)
+				if err != nil {
+					continue
+				}
+				for _, cCase := range analysisCaseOrder {
+					if sameForm(forms[cCase], form) {
+						add(Analysis{Lemma: lemma.String(), Case: cCase, Number: number.Plural, Gender: gender.Invalid})
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+/**
+ * Правописание окончания начальной формы: после г, к, х и шипящих пишется -ий (русский, хороший),
+ * -ий после других согласных встречается только у мягких прилагательных на -н (синий, последний).
+ * @param string $stem
+ * @param string $ending
+ * @return bool
+ */
+func isLemmaSpelled(stem str.Word, ending string) bool {
+	last := stem.LastChars(1)
+	afterVelarOrHissing := russian.IsVelarConsonant(last) || russian.IsHissingConsonant(last)
+	switch ending {
+	case "ый":
+		return !afterVelarOrHissing
+	case "ий":
+		return afterVelarOrHissing || last == "н"
+	}
+	return true
+}
+
+/**
+ * Сравнение словоформ без учета различия е/ё.
+ * @param string $form
+ * @param string $other
+ * @return bool
+ */
+func sameForm(form, other string) bool {
+	return strings.Replace(form, "ё", "е", -1) == strings.Replace(other, "ё", "е", -1)
+}
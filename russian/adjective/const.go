@@ -1,7 +1,24 @@
 package adjective
 
+import "github.com/dshipenok/gomorphos/str"
+
 const (
-	HardBase  = 1
-	SoftBase  = 2
-	MixedBase = 3
+	HardBase    = 1
+	SoftBase    = 2
+	MixedBase   = 3
+	HissingBase = 4
 )
+
+// виды причастий
+const (
+	NotParticiple  = 0
+	ActivePresent  = 1 // работающий
+	ActivePast     = 2 // сделавший
+	PassivePresent = 3 // читаемый
+	PassivePast    = 4 // прочитанный, взятый
+)
+
+/** @var string[] Основы на шипящий с ударным окончанием, у которых по женскому и среднему роду не видно ударения: большая, чужое */
+var stressedHissingStems = str.NewWordSet([]string{
+	"больш", "чуж", "меньш",
+})
@@ -78,6 +78,11 @@ func DetectGender(w str.Word, isEmphasized *bool) gender.Gender {
  * @phpstan-return array<string, string>
  */
 func GetCases(w str.Word, animateness bool, gendr gender.Gender) (cases.Cases, error) {
+	result, err := getCases(w.Lower(), animateness, gendr)
+	return cases.ApplyCasePattern(w, result), err
+}
+
+func getCases(w str.Word, animateness bool, gendr gender.Gender) (cases.Cases, error) {
 	if gendr == gender.Invalid {
 		isEmphasized := false
 		gendr = DetectGender(w, &isEmphasized)
@@ -88,6 +93,9 @@ func GetCases(w str.Word, animateness bool, gendr gender.Gender) (cases.Cases, e
 
 	lastConsonantVowel := w.SliceWord(-2, -1)
 	baseType := GetAdjectiveBaseType(w)
+	if DetectGender(w, nil) != gendr {
+		lastConsonantVowel = getGenderVowel(baseType, gendr)
+	}
 	w = w.SliceWord(0, -2)
 
 	switch baseType {
@@ -99,11 +107,77 @@ func GetCases(w str.Word, animateness bool, gendr gender.Gender) (cases.Cases, e
 
 	case MixedBase:
 		return declinateMixedAdjective(w, animateness, gendr, lastConsonantVowel)
+
+	case HissingBase:
+		return declinateHissingAdjective(w, animateness, gendr, lastConsonantVowel)
 	}
 
 	return cases.NewCasesWord(w), errors.New("invalid adjective base")
 }
 
+/**
+* Склонение прилагательного во множественном числе.
+* @param string $adjective
+* @param bool $animateness
+*
+* @return string[]
+ */
+func GetPluralCases(w str.Word, animateness bool) (cases.Cases, error) {
+	result, err := getPluralCases(w.Lower(), animateness)
+	return cases.ApplyCasePattern(w, result), err
+}
+
+func getPluralCases(w str.Word, animateness bool) (cases.Cases, error) {
+	if DetectGender(w, nil) == gender.Invalid {
+		return cases.NewCasesWord(w), errors.New("unable to detect adjective gender")
+	}
+
+	vowel := "ы"
+	if GetAdjectiveBaseType(w) != HardBase {
+		vowel = "и"
+	}
+	prefix := w.SliceWord(0, -2)
+
+	cCases := cases.Cases{
+		cases.Imenit:  prefix.Concat(vowel, "е"),
+		cases.Rodit:   prefix.Concat(vowel, "х"),
+		cases.Dat:     prefix.Concat(vowel, "м"),
+		cases.Tvorit:  prefix.Concat(vowel, "ми"),
+		cases.Predloj: prefix.Concat(vowel, "х"),
+	}
+	cCases[cases.Vinit] = russian.GetVinitCaseByAnimateness(cCases, animateness)
+
+	return cCases, nil
+}
+
+/**
+* Гласная окончания именительного падежа для заданного рода:
+* нужна, когда прилагательное передано в форме другого рода (красный -> красная).
+* @param int $baseType
+* @param string $gender
+*
+* @return string
+ */
+func getGenderVowel(baseType int, gendr gender.Gender) str.Word {
+	switch gendr {
+	case gender.Female:
+		if baseType == SoftBase {
+			return str.Word("я")
+		}
+		return str.Word("а")
+	case gender.Neuter:
+		if baseType == SoftBase || baseType == HissingBase {
+			return str.Word("е")
+		}
+		return str.Word("о")
+	default:
+		if baseType == HardBase {
+			return str.Word("ы")
+		}
+		return str.Word("и")
+	}
+}
+
 /**
 * @param string $adjective
 *
@@ -112,15 +186,30 @@ func GetCases(w str.Word, animateness bool, gendr gender.Gender) (cases.Cases, e
 func GetAdjectiveBaseType(w str.Word) int {
 	w = w.Lower()
 
+	switch GetParticipleType(w) {
+	case ActivePresent, ActivePast:
+		return HissingBase
+	case PassivePresent, PassivePast:
+		return HardBase
+	}
+
 	substring := russian.FindLastPositionForOneOfChars(w, russian.ConsonantsAdj)
 	lastConsonant := substring.SliceWord(0, 1)
 
-	// г, к, х, ударное ш - признак смешанного прилагательно
-	if lastConsonant.OneOf("г", "к", "х") ||
-		(lastConsonant.String() == "ш" && substring.SliceWord(1, 2).OneOf("о", "а")) {
+	// г, к, х - признак смешанного прилагательного
+	if lastConsonant.OneOf("г", "к", "х") {
 		return MixedBase
 	}
 
+	if russian.IsHissingConsonant(lastConsonant.String()) {
+		// ударное окончание после шипящего склоняется как смешанное: большой, чужой, большая
+		if w.EndsWith(2, "ой") || (!w.EndsWith(2, "ий") && stressedHissingStems.HasStr(w.Chars(0, -2))) {
+			return MixedBase
+		}
+		// безударное окончание - шипящая основа (хороший, свежая, горячее)
+		return HissingBase
+	}
+
 	if russian.CheckBaseLastConsonantSoftness(substring) || substring.SliceWord(0, 2).String() == "шн" {
 		return SoftBase
 	}
@@ -175,8 +264,11 @@ func declinateHardAdjective(w str.Word, animateness bool, gendr gender.Gender, a
 		cases.Predloj: predloj,
 	}
 
-	if gendr != gender.Female {
+	switch gendr {
+	case gender.Male:
 		cCases[cases.Vinit] = russian.GetVinitCaseByAnimateness(cCases, animateness)
+	case gender.Neuter:
+		cCases[cases.Vinit] = cCases[cases.Imenit]
 	}
 
 	return cCases, nil
@@ -230,8 +322,11 @@ func declinateSoftAdjective(w str.Word, animateness bool, gendr gender.Gender, a
 		cases.Predloj: predloj,
 	}
 
-	if gendr != gender.Female {
+	switch gendr {
+	case gender.Male:
 		cCases[cases.Vinit] = russian.GetVinitCaseByAnimateness(cCases, animateness)
+	case gender.Neuter:
+		cCases[cases.Vinit] = cCases[cases.Imenit]
 	}
 
 	return cCases, nil
@@ -294,3 +389,63 @@ func declinateMixedAdjective(w str.Word, animateness bool, gendr gender.Gender,
 
 	return cCases, nil
 }
+
+/**
+* Склонение прилагательных с основой на шипящий: после ж, ш, ч, щ
+* в женском роде пишется -ая/-ую, в остальных формах окончания мягкого типа.
+* @param string $adjective
+* @param bool   $animateness
+* @param string $gender
+* @param string $afterConsonantVowel
+*
+* @return string[]
+* @phpstan-return array<string, string>
+ */
+func declinateHissingAdjective(w str.Word, animateness bool, gendr gender.Gender, afterConsonantVowel str.Word) (cases.Cases, error) {
+	var postfix string
+	switch gendr {
+	case gender.Male:
+		postfix = afterConsonantVowel.Concat("й")
+
+	case gender.Female:
+		postfix = afterConsonantVowel.Concat("я")
+
+	case gender.Neuter:
+		postfix = afterConsonantVowel.Concat("е")
+
+	default:
+		return cases.NewCasesWord(w), errors.New("invalid gender in hissing adjective")
+	}
+
+	var rodit, dat, vinit, tvorit, predloj string
+	if gendr != gender.Female {
+		rodit = w.Concat("е", "го")
+		dat = w.Concat("е", "му")
+		tvorit = w.Concat("им")
+		predloj = w.Concat("ем")
+	} else {
+		rodit = w.Concat("е", "й")
+		dat = w.Concat("е", "й")
+		vinit = w.Concat("ую")
+		tvorit = w.Concat("ей")
+		predloj = w.Concat("ей")
+	}
+
+	cCases := cases.Cases{
+		cases.Imenit:  w.Concat(postfix),
+		cases.Rodit:   rodit,
+		cases.Dat:     dat,
+		cases.Vinit:   vinit,
+		cases.Tvorit:  tvorit,
+		cases.Predloj: predloj,
+	}
+
+	switch gendr {
+	case gender.Male:
+		cCases[cases.Vinit] = russian.GetVinitCaseByAnimateness(cCases, animateness)
+	case gender.Neuter:
+		cCases[cases.Vinit] = cCases[cases.Imenit]
+	}
+
+	return cCases, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/dshipenok/gomorphos/russian/cases"
 	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/russian/number"
 	"github.com/dshipenok/gomorphos/str"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,6 +32,19 @@ func Test_GetCases(t *testing.T) {
 			},
 		},
 
+		{
+			Word:   "Красный",
+			Gender: gender.Invalid,
+			Cases: cases.Cases{
+				cases.Imenit:  "Красный",
+				cases.Rodit:   "Красного",
+				cases.Dat:     "Красному",
+				cases.Vinit:   "Красный",
+				cases.Tvorit:  "Красным",
+				cases.Predloj: "Красном",
+			},
+		},
+
 		{
 			Word:   "синяя",
 			Gender: gender.Invalid,
@@ -124,6 +138,46 @@ func Test_GetCases(t *testing.T) {
 			},
 		},
 
+		{
+			Word:   "работающая",
+			Gender: gender.Invalid,
+			Cases: cases.Cases{
+				cases.Imenit:  "работающая",
+				cases.Rodit:   "работающей",
+				cases.Dat:     "работающей",
+				cases.Vinit:   "работающую",
+				cases.Tvorit:  "работающей",
+				cases.Predloj: "работающей",
+			},
+		},
+
+		{
+			Word:   "созданный",
+			Gender: gender.Female,
+			Cases: cases.Cases{
+				cases.Imenit:  "созданная",
+				cases.Rodit:   "созданной",
+				cases.Dat:     "созданной",
+				cases.Vinit:   "созданную",
+				cases.Tvorit:  "созданной",
+				cases.Predloj: "созданной",
+			},
+		},
+
+		{
+			Word:        "сделавший",
+			Gender:      gender.Invalid,
+			Animateness: true,
+			Cases: cases.Cases{
+				cases.Imenit:  "сделавший",
+				cases.Rodit:   "сделавшего",
+				cases.Dat:     "сделавшему",
+				cases.Vinit:   "сделавшего",
+				cases.Tvorit:  "сделавшим",
+				cases.Predloj: "сделавшем",
+			},
+		},
+
 		{
 			Disabled: true, // притяжательные не поддерживаются
 			Word:     "волчий",
@@ -150,6 +204,42 @@ func Test_GetCases(t *testing.T) {
 				cases.Predloj: "папином",
 			},
 		},
+		{
+			Word:   "чужой",
+			Gender: gender.Invalid,
+			Cases: cases.Cases{
+				cases.Imenit:  "чужой",
+				cases.Rodit:   "чужого",
+				cases.Dat:     "чужому",
+				cases.Vinit:   "чужой",
+				cases.Tvorit:  "чужим",
+				cases.Predloj: "чужом",
+			},
+		},
+		{
+			Word:   "большой",
+			Gender: gender.Invalid,
+			Cases: cases.Cases{
+				cases.Imenit:  "большой",
+				cases.Rodit:   "большого",
+				cases.Dat:     "большому",
+				cases.Vinit:   "большой",
+				cases.Tvorit:  "большим",
+				cases.Predloj: "большом",
+			},
+		},
+		{
+			Word:   "хорошая",
+			Gender: gender.Invalid,
+			Cases: cases.Cases{
+				cases.Imenit:  "хорошая",
+				cases.Rodit:   "хорошей",
+				cases.Dat:     "хорошей",
+				cases.Vinit:   "хорошую",
+				cases.Tvorit:  "хорошей",
+				cases.Predloj: "хорошей",
+			},
+		},
 	}
 
 	for _, tst := range tests {
@@ -166,3 +256,38 @@ func Test_GetCases(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetShortForms(t *testing.T) {
+	tests := []struct {
+		Word  string
+		Forms []string
+	}{
+		{Word: "сделанный", Forms: []string{"сделан", "сделана", "сделано", "сделаны"}},
+		{Word: "созданная", Forms: []string{"создан", "создана", "создано", "созданы"}},
+		{Word: "взятый", Forms: []string{"взят", "взята", "взято", "взяты"}},
+		{Word: "любимый", Forms: []string{"любим", "любима", "любимо", "любимы"}},
+		{Word: "решённый", Forms: []string{"решён", "решена", "решено", "решены"}},
+		{Word: "принесённая", Forms: []string{"принесён", "принесена", "принесено", "принесены"}},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Word, func(t *testing.T) {
+			forms, err := GetShortForms(str.Word(tst.Word))
+
+			require.NoError(t, err)
+			assert.Equal(t, tst.Forms, forms)
+		})
+	}
+}
+
+func Test_GetParticipleType(t *testing.T) {
+	assert.Equal(t, ActivePresent, GetParticipleType(str.Word("работающий")))
+	assert.Equal(t, ActivePast, GetParticipleType(str.Word("сделавшая")))
+	assert.Equal(t, PassivePresent, GetParticipleType(str.Word("читаемое")))
+	assert.Equal(t, PassivePast, GetParticipleType(str.Word("прочитанные")))
+	assert.Equal(t, NotParticiple, GetParticipleType(str.Word("деревянный")))
+	assert.Equal(t, NotParticiple, GetParticipleType(str.Word("золотой")))
+
+	_, err := GetShortForm(str.Word("работающий"), gender.Male, number.Singular)
+	assert.Error(t, err)
+}
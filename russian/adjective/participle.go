@@ -0,0 +1,137 @@
+package adjective
+
+import (
+	"errors"
+
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Основы прилагательных, похожих на причастия по суффиксу.
+ * @var string[]
+ */
+var nonParticiples = str.NewWordSet([]string{
+	"деревянн", "стеклянн", "оловянн", "длинн", "каменн",
+	"военн", "современн", "откровенн", "мгновенн", "обыкновенн", "священн", "ценн", "драгоценн",
+	"настоящ", "высш", "лучш", "худш", "младш",
+})
+
+/**
+ * Определение вида причастия по суффиксу.
+ * @param string $participle Полная форма в любом роде или во множественном числе
+ * @return int
+ */
+func GetParticipleType(w str.Word) int {
+	w = w.Lower()
+	if w.Len() < 5 || !w.EndsWith(2, "ый", "ий", "ой", "ая", "яя", "ое", "ее", "ые", "ие") {
+		return NotParticiple
+	}
+
+	stem := w.SliceWord(0, -2)
+	if nonParticiples.Has(stem) {
+		return NotParticiple
+	}
+
+	switch {
+	// работающий, читающий, говорящий
+	case stem.EndsWith(2, "ущ", "ющ", "ащ", "ящ"):
+		return ActivePresent
+
+	// сделавший, принёсший, пришедший
+	case stem.EndsWith(2, "вш", "сш", "зш", "кш", "гш", "бш", "пш", "тш", "дш"):
+		return ActivePast
+
+	// читаемый, любимый, ведомый
+	case stem.Len() > 3 && stem.EndsWith(2, "ем", "им", "ом") && !w.EndsWith(2, "ой"):
+		return PassivePresent
+
+	// прочитанный, сделанный, полученный; мягкие окончания только у прилагательных: осенний, ранний
+	case stem.EndsWith(3, "анн", "янн", "енн", "ённ"):
+		if w.EndsWith(2, "ий", "яя", "ее", "ие") {
+			return NotParticiple
+		}
+		return PassivePast
+
+	// взятый, открытый, тронутый, стёртый
+	case stem.EndsWith(2, "ят", "ыт", "ит", "ут") || stem.EndsWith(3, "ерт", "ёрт"):
+		if w.EndsWith(2, "ой") {
+			return NotParticiple
+		}
+		return PassivePast
+	}
+
+	return NotParticiple
+}
+
+/**
+ * Проверка, является ли слово причастием.
+ * @param string $word
+ * @return bool
+ */
+func IsParticiple(w str.Word) bool {
+	return GetParticipleType(w) != NotParticiple
+}
+
+/**
+ * Краткая форма страдательного причастия: сделанный - сделан, сделана, сделано, сделаны.
+ * @param string $participle
+ * @param string $gender
+ * @param string $number
+ * @return string
+ * @throws \Exception
+ */
+func GetShortForm(w str.Word, gendr gender.Gender, num number.Number) (string, error) {
+	participleType := GetParticipleType(w)
+	if participleType != PassivePast && participleType != PassivePresent {
+		return w.String(), errors.New("short form exists only for passive participles")
+	}
+
+	stem := w.Lower().SliceWord(0, -2)
+	if participleType == PassivePast && stem.EndsWith(2, "нн") {
+		stem = stem.SliceWord(0, -1)
+	}
+
+	// ё в суффиксе остается только в мужском роде: решён - решена, решено, решены
+	if stem.EndsWith(2, "ён") && (num == number.Plural || gendr != gender.Male) {
+		stem = str.Word(stem.Chars(0, -2) + "ен")
+	}
+
+	if num == number.Plural {
+		return stem.Concat("ы"), nil
+	}
+
+	switch gendr {
+	case gender.Female:
+		return stem.Concat("а"), nil
+	case gender.Neuter:
+		return stem.Concat("о"), nil
+	case gender.Male:
+		return stem.String(), nil
+	}
+	return w.String(), errors.New("invalid gender for short form")
+}
+
+/**
+ * Все краткие формы страдательного причастия.
+ * @param string $participle
+ * @return string[] мужской, женский, средний род, множественное число
+ * @throws \Exception
+ */
+func GetShortForms(w str.Word) ([]string, error) {
+	forms := make([]string, 0, 4)
+	for _, gendr := range []gender.Gender{gender.Male, gender.Female, gender.Neuter} {
+		form, err := GetShortForm(w, gendr, number.Singular)
+		if err != nil {
+			return nil, err
+		}
+		forms = append(forms, form)
+	}
+
+	plural, err := GetShortForm(w, gender.Invalid, number.Plural)
+	if err != nil {
+		return nil, err
+	}
+	return append(forms, plural), nil
+}
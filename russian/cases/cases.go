@@ -76,3 +76,16 @@ func CanonizeCase(wCase string) Case {
 		return Imenit
 	}
 }
+
+/**
+ * Перенос регистра исходного слова на все его формы: Москва - Москвы, НАСА - НАСЫ.
+ * @param string $word Исходное слово
+ * @param string[] $forms
+ * @return string[]
+ */
+func ApplyCasePattern(w str.Word, forms map[Case]string) map[Case]string {
+	for cCase, form := range forms {
+		forms[cCase] = str.ApplyCase(w, form)
+	}
+	return forms
+}
@@ -0,0 +1,68 @@
+package datetime
+
+import "time"
+
+// направление отсчета интервала
+const (
+	NoDirection = 0 // 2 часа 15 минут
+	Past        = 1 // 2 часа 15 минут назад
+	Future      = 2 // через 2 часа 15 минут
+)
+
+/**
+ * Единицы измерения интервала от большей к меньшей.
+ * Год считается равным 365 дням, месяц - 30 дням.
+ */
+var durationUnits = []struct {
+	Duration time.Duration
+	Word     string
+}{
+	{365 * 24 * time.Hour, "год"},
+	{30 * 24 * time.Hour, "месяц"},
+	{24 * time.Hour, "день"},
+	{time.Hour, "час"},
+	{time.Minute, "минута"},
+	{time.Second, "секунда"},
+}
+
+var months = []string{
+	"", "январь", "февраль", "март", "апрель", "май", "июнь",
+	"июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь",
+}
+
+// индекс соответствует time.Weekday: 0 - воскресенье
+var weekdays = []string{
+	"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота",
+}
+
+/**
+ * Элементы шаблона даты для FormatDate. Более длинные элементы проверяются раньше.
+ */
+const (
+	TokenMonthGenitive = "MMMM" // месяц в родительном падеже: октября
+	TokenYear          = "YYYY" // год: 2026
+	TokenDayPadded     = "DD"   // день с ведущим нулем: 05
+	TokenDayOrdinal    = "Do"   // день порядковым числительным в родительном падеже: 18-го
+	TokenDaySpelled    = "Ds"   // день словами в родительном падеже: восемнадцатого
+	TokenMonthPadded   = "MM"   // номер месяца с ведущим нулем: 10
+	TokenYearSpelled   = "Ys"   // год словами в родительном падеже: две тысячи двадцать шестого
+	TokenWeekdayIn     = "WW"   // день недели с предлогом "в": в понедельник, во вторник
+	TokenDay           = "D"    // день: 18
+	TokenMonth         = "M"    // месяц в именительном падеже: октябрь
+	TokenWeekday       = "W"    // день недели: понедельник
+)
+
+var dateTokens = []string{
+	TokenMonthGenitive, TokenYear,
+	TokenDayPadded, TokenDayOrdinal, TokenDaySpelled, TokenMonthPadded, TokenYearSpelled, TokenWeekdayIn,
+	TokenDay, TokenMonth, TokenWeekday,
+}
+
+// названия ближайших дней: смещение в днях от текущей даты
+var relativeDays = map[int]string{
+	-2: "позавчера",
+	-1: "вчера",
+	0:  "сегодня",
+	1:  "завтра",
+	2:  "послезавтра",
+}
@@ -0,0 +1,115 @@
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/russian/numeral"
+	"github.com/dshipenok/gomorphos/russian/preposition"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Запись даты по шаблону: "D MMMM YYYY г." - 18 октября 2026 г., "WW, Do" - в понедельник, 18-го,
+ * "Ds MMMM Ys года" - восемнадцатого октября две тысячи двадцать шестого года.
+ * Элементы шаблона перечислены в константах Token*, остальной текст копируется как есть.
+ * @param int $time
+ * @param string $layout
+ * @return string
+ */
+func FormatDate(t time.Time, layout string) string {
+	var result strings.Builder
+	for len(layout) > 0 {
+		token := findToken(layout)
+		if token == "" {
+			r := []rune(layout)[0]
+			result.WriteRune(r)
+			layout = layout[len(string(r)):]
+			continue
+		}
+
+		result.WriteString(formatToken(t, token))
+		layout = layout[len(token):]
+	}
+	return result.String()
+}
+
+/**
+ * Получение названия месяца во всех 6 падежах: октябрь, октября, ...
+ * @param int $month
+ * @return string[]
+ */
+func GetMonthCases(m time.Month) cases.Cases {
+	return declension.GetCases(str.Word(months[m]), false)
+}
+
+/**
+ * Получение названия дня недели во всех 6 падежах: среда, среды, ...
+ * @param int $weekday
+ * @return string[]
+ */
+func GetWeekdayCases(wd time.Weekday) cases.Cases {
+	return declension.GetCases(str.Word(weekdays[wd]), false)
+}
+
+/**
+ * День недели с предлогом "в" (когда?): в понедельник, во вторник, в среду.
+ * @param int $weekday
+ * @return string
+ */
+func GetWeekdayWithPreposition(wd time.Weekday) string {
+	return preposition.Join(str.Word("в"), GetWeekdayCases(wd)[cases.Vinit])
+}
+
+/**
+ * @param string $layout
+ * @return string Элемент шаблона, с которого начинается строка, или пустая строка
+ */
+func findToken(layout string) string {
+	for _, token := range dateTokens {
+		if strings.HasPrefix(layout, token) {
+			return token
+		}
+	}
+	return ""
+}
+
+/**
+ * @param int $time
+ * @param string $token
+ * @return string
+ */
+func formatToken(t time.Time, token string) string {
+	switch token {
+	case TokenMonthGenitive:
+		return GetMonthCases(t.Month())[cases.Rodit]
+	case TokenYear:
+		return strconv.Itoa(t.Year())
+	case TokenDayPadded:
+		return fmt.Sprintf("%02d", t.Day())
+	case TokenDayOrdinal:
+		return strconv.Itoa(t.Day()) + "-го"
+	case TokenDaySpelled:
+		// восемнадцатого (числа)
+		return numeral.GetOrdinalCases(int64(t.Day()), gender.Neuter, false)[cases.Rodit]
+	case TokenMonthPadded:
+		return fmt.Sprintf("%02d", int(t.Month()))
+	case TokenYearSpelled:
+		// две тысячи двадцать шестого (года)
+		return numeral.GetOrdinalCases(int64(t.Year()), gender.Male, false)[cases.Rodit]
+	case TokenWeekdayIn:
+		return GetWeekdayWithPreposition(t.Weekday())
+	case TokenDay:
+		return strconv.Itoa(t.Day())
+	case TokenMonth:
+		return months[t.Month()]
+	case TokenWeekday:
+		return weekdays[t.Weekday()]
+	}
+	return token
+}
@@ -0,0 +1,59 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+)
+
+func Test_FormatDate(t *testing.T) {
+	date := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		Layout string
+		Result string
+	}{
+		{Layout: "D MMMM YYYY г.", Result: "18 октября 2026 г."},
+		{Layout: "Ds MMMM", Result: "восемнадцатого октября"},
+		{Layout: "WW, Do", Result: "в воскресенье, 18-го"},
+		{Layout: "Ds MMMM Ys года", Result: "восемнадцатого октября две тысячи двадцать шестого года"},
+		{Layout: "DD.MM.YYYY", Result: "18.10.2026"},
+		{Layout: "W, M", Result: "воскресенье, октябрь"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Layout, func(t *testing.T) {
+			assert.Equal(t, tst.Result, FormatDate(date, tst.Layout))
+		})
+	}
+
+	assert.Equal(t, "03.05", FormatDate(time.Date(2026, time.May, 3, 0, 0, 0, 0, time.UTC), "DD.MM"))
+	assert.Equal(t, "третьего мая", FormatDate(time.Date(2026, time.May, 3, 0, 0, 0, 0, time.UTC), "Ds MMMM"))
+}
+
+func Test_GetWeekdayWithPreposition(t *testing.T) {
+	tests := []struct {
+		Weekday time.Weekday
+		Result  string
+	}{
+		{Weekday: time.Monday, Result: "в понедельник"},
+		{Weekday: time.Tuesday, Result: "во вторник"},
+		{Weekday: time.Wednesday, Result: "в среду"},
+		{Weekday: time.Friday, Result: "в пятницу"},
+		{Weekday: time.Saturday, Result: "в субботу"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Result, func(t *testing.T) {
+			assert.Equal(t, tst.Result, GetWeekdayWithPreposition(tst.Weekday))
+		})
+	}
+}
+
+func Test_GetMonthCases(t *testing.T) {
+	assert.Equal(t, "мая", GetMonthCases(time.May)[cases.Rodit])
+	assert.Equal(t, "марте", GetMonthCases(time.March)[cases.Predloj])
+}
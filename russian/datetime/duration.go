@@ -0,0 +1,100 @@
+package datetime
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/phrase"
+)
+
+/**
+ * Параметры записи интервала.
+ */
+type Options struct {
+	Precision int        // количество единиц измерения (0 - все): 1 год 2 месяца при точности 2
+	Case      cases.Case // падеж: в течение двух часов (родительный)
+	Direction int        // Past - "назад", Future - "через", NoDirection - без направления
+	Spelled   bool       // запись чисел словами: два часа вместо 2 часа
+}
+
+/**
+ * Запись интервала времени словами: 1 год 2 месяца, через 3 дня, 5 минут назад.
+ * Интервал меньше секунды записывается как 0 секунд. Знак интервала не учитывается,
+ * направление задается в параметрах.
+ * @param int $duration
+ * @param array $options
+ * @return string
+ */
+func Humanize(d time.Duration, opts Options) string {
+	// модуль считается без знака: -d переполняется для math.MinInt64
+	abs := uint64(d)
+	if d < 0 {
+		abs = uint64(-(d + 1)) + 1
+	}
+
+	cCase := opts.Case
+	if opts.Direction != NoDirection {
+		// через два часа, два часа назад
+		cCase = cases.Vinit
+	}
+
+	parts := []string{}
+	for _, unit := range durationUnits {
+		count := int64(abs / uint64(unit.Duration))
+		if count == 0 {
+			continue
+		}
+		abs %= uint64(unit.Duration)
+
+		parts = append(parts, formatCount(count, unit.Word, cCase, opts.Spelled))
+		if opts.Precision > 0 && len(parts) == opts.Precision {
+			break
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, formatCount(0, durationUnits[len(durationUnits)-1].Word, cCase, opts.Spelled))
+	}
+
+	result := strings.Join(parts, " ")
+	switch opts.Direction {
+	case Past:
+		return result + " назад"
+	case Future:
+		return "через " + result
+	}
+	return result
+}
+
+/**
+ * Запись интервала относительно текущего момента: отрицательный интервал - в прошлом (5 минут назад),
+ * положительный - в будущем (через 3 дня).
+ * @param int $duration
+ * @param int $precision Количество единиц измерения (0 - все)
+ * @return string
+ */
+func Relative(d time.Duration, precision int) string {
+	direction := Future
+	if d < 0 {
+		direction = Past
+	}
+	return Humanize(d, Options{Precision: precision, Direction: direction})
+}
+
+/**
+ * @param int $count
+ * @param string $unit
+ * @param string $case
+ * @param bool $spelled
+ * @return string
+ */
+func formatCount(count int64, unit string, cCase cases.Case, spelled bool) string {
+	if spelled {
+		forms, _ := phrase.GetCountCases(count, unit, false)
+		return forms[cCase]
+	}
+
+	form, _ := phrase.Pluralize(count, unit, false, cCase)
+	return strconv.FormatInt(count, 10) + " " + form
+}
@@ -0,0 +1,43 @@
+package datetime
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+)
+
+func Test_Humanize(t *testing.T) {
+	const day = 24 * time.Hour
+
+	tests := []struct {
+		Duration time.Duration
+		Options  Options
+		Result   string
+	}{
+		{Duration: 2*time.Hour + 15*time.Minute, Result: "2 часа 15 минут"},
+		{Duration: 2*time.Hour + 15*time.Minute, Options: Options{Direction: Past}, Result: "2 часа 15 минут назад"},
+		{Duration: 3 * day, Options: Options{Direction: Future}, Result: "через 3 дня"},
+		{Duration: -5 * time.Minute, Options: Options{Direction: Past}, Result: "5 минут назад"},
+		{Duration: 427*day + 3*time.Hour, Options: Options{Precision: 2}, Result: "1 год 2 месяца"},
+		{Duration: 21 * time.Minute, Options: Options{Direction: Future}, Result: "через 21 минуту"},
+		{Duration: 2 * time.Hour, Options: Options{Case: cases.Rodit, Spelled: true}, Result: "двух часов"},
+		{Duration: time.Minute + time.Second, Options: Options{Spelled: true}, Result: "одна минута одна секунда"},
+		{Duration: 500 * time.Millisecond, Result: "0 секунд"},
+		{Duration: math.MinInt64, Options: Options{Precision: 1}, Result: "292 года"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Result, func(t *testing.T) {
+			assert.Equal(t, tst.Result, Humanize(tst.Duration, tst.Options))
+		})
+	}
+}
+
+func Test_Relative(t *testing.T) {
+	assert.Equal(t, "через 3 дня", Relative(3*24*time.Hour+time.Minute, 1))
+	assert.Equal(t, "1 час 30 минут назад", Relative(-90*time.Minute, 0))
+}
@@ -0,0 +1,76 @@
+package datetime
+
+import (
+	"time"
+
+	"github.com/dshipenok/gomorphos/russian/adjective"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/russian/preposition"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Запись даты относительно текущей: сегодня, вчера, позавчера, завтра, послезавтра;
+ * в пределах текущей недели - в среду; на прошлой и следующей неделе - в прошлую среду,
+ * в следующий понедельник. Более далекие даты записываются с названием месяца: 18 октября (18 октября 2025 г.
+ * для другого года). Неделя начинается с понедельника.
+ * @param int $time
+ * @param int $now Текущий момент
+ * @return string
+ */
+func FormatRelativeDate(t, now time.Time) string {
+	t = t.In(now.Location())
+	days := daysBetween(now, t)
+	if word, has := relativeDays[days]; has {
+		return word
+	}
+
+	switch daysBetween(startOfWeek(now), startOfWeek(t)) / 7 {
+	case 0:
+		return GetWeekdayWithPreposition(t.Weekday())
+	case -1:
+		return formatWeekdayWithAdjective(t.Weekday(), "прошлый")
+	case 1:
+		return formatWeekdayWithAdjective(t.Weekday(), "следующий")
+	}
+
+	if t.Year() == now.Year() {
+		return FormatDate(t, "D MMMM")
+	}
+	return FormatDate(t, "D MMMM YYYY г.")
+}
+
+/**
+ * День недели с предлогом "в" и согласованным прилагательным: в прошлую среду, в следующий понедельник.
+ * @param int $weekday
+ * @param string $adjective
+ * @return string
+ */
+func formatWeekdayWithAdjective(wd time.Weekday, adj string) string {
+	weekday := str.Word(weekdays[wd])
+	adjForms, _ := adjective.GetCases(str.Word(adj), false, declension.DetectGender(weekday))
+	return preposition.Join(str.Word("в"), adjForms[cases.Vinit]+" "+GetWeekdayCases(wd)[cases.Vinit])
+}
+
+/**
+ * Количество календарных дней между датами.
+ * @param int $from
+ * @param int $till
+ * @return int
+ */
+func daysBetween(from, till time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	tillDate := time.Date(till.Year(), till.Month(), till.Day(), 0, 0, 0, 0, time.UTC)
+	return int(tillDate.Sub(fromDate) / (24 * time.Hour))
+}
+
+/**
+ * Понедельник недели, в которую входит дата.
+ * @param int $time
+ * @return int
+ */
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return t.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,41 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FormatRelativeDate(t *testing.T) {
+	// среда
+	now := time.Date(2026, time.October, 21, 15, 0, 0, 0, time.UTC)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 9, 30, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		Time   time.Time
+		Result string
+	}{
+		{Time: date(time.October, 21), Result: "сегодня"},
+		{Time: date(time.October, 20), Result: "вчера"},
+		{Time: date(time.October, 19), Result: "позавчера"},
+		{Time: date(time.October, 22), Result: "завтра"},
+		{Time: date(time.October, 23), Result: "послезавтра"},
+		{Time: date(time.October, 25), Result: "в воскресенье"},
+		{Time: date(time.October, 14), Result: "в прошлую среду"},
+		{Time: date(time.October, 13), Result: "в прошлый вторник"},
+		{Time: date(time.October, 18), Result: "в прошлое воскресенье"},
+		{Time: date(time.October, 26), Result: "в следующий понедельник"},
+		{Time: date(time.October, 30), Result: "в следующую пятницу"},
+		{Time: date(time.September, 1), Result: "1 сентября"},
+		{Time: time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC), Result: "31 декабря 2025 г."},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Result, func(t *testing.T) {
+			assert.Equal(t, tst.Result, FormatRelativeDate(tst.Time, now))
+		})
+	}
+}
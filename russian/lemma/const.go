@@ -0,0 +1,53 @@
+package lemma
+
+import "github.com/dshipenok/gomorphos/str"
+
+/**
+ * Окончания, которые бывают только у прилагательных и причастий: нового, синему, красную.
+ * @var string[]
+ */
+var adjectiveEndings = []string{
+	"ого", "его", "ому", "ему", "ыми", "ым", "ых", "ую", "юю", "ая", "яя", "ый", "ое", "ее", "ые",
+}
+
+/**
+ * Окончания, общие для прилагательных и существительных: новой - кошкой, синие - здание.
+ * @var string[]
+ */
+var ambiguousEndings = []string{
+	"ими", "ий", "ой", "ей", "ие", "им", "их", "ом", "ем",
+}
+
+/**
+ * Суффиксы основ, по которым слово с неоднозначным окончанием считается прилагательным:
+ * новой, русских, красном, большом, младшем, высшей, лучшим.
+ * @var string[]
+ */
+var adjectiveSuffixes = []string{
+	"ск", "цк", "ов", "ев", "ёв", "ьн", "ин", "ьш", "дш", "сш", "чш",
+}
+
+/**
+ * Служебные слова и наречия, которые не изменяются и не разбираются: предлоги, союзы, частицы,
+ * наречия времени и места (вчера, сегодня, домой).
+ * @var string[]
+ */
+var functionWords = str.NewWordSet([]string{
+	"в", "во", "на", "о", "об", "обо", "по", "к", "ко", "с", "со", "у", "из", "изо", "за", "от", "ото",
+	"до", "без", "для", "при", "про", "над", "под", "через", "перед", "между", "около", "после",
+	"из-за", "из-под", "и", "а", "но", "да", "или", "либо", "что", "чтобы", "как", "если", "когда",
+	"не", "ни", "же", "ли", "бы", "вот", "уже", "ещё", "еще", "очень", "так", "там", "тут", "где", "нет",
+	"вчера", "сегодня", "завтра", "сейчас", "теперь", "тогда", "потом", "всегда", "никогда", "иногда",
+	"давно", "снова", "опять", "здесь", "сюда", "туда", "домой", "тоже", "также", "даже", "только",
+})
+
+/**
+ * Окончания глагольных форм. Глаголы не разбираются и возвращаются как есть, иначе
+ * анализатор существительных принимает их за формы существительных (говорили - говориль, гуляли - гуляль).
+ * Слова из словаря существительных проверяются раньше: недели, цели.
+ * @var string[]
+ */
+var verbEndings = []string{
+	"ться", "тся", "лся", "лась", "лось", "лись", "ть", "ешь", "ёшь", "ишь", "ете", "ёте", "ают", "яют",
+	"али", "яли", "ели", "или",
+}
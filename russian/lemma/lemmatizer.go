@@ -0,0 +1,203 @@
+package lemma
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/russian/adjective"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/russian/pronoun"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Разбиение текста на слова и приведение каждого слова к начальной форме:
+ * "В новых кухнях" - [в новый кухня].
+ * Служебные слова, глаголы, нерусские слова и числа возвращаются как есть в нижнем регистре.
+ * @param string $text
+ * @return string[]
+ */
+func Lemmatize(text string) []string {
+	tokens := Tokenize(text)
+	lemmas := make([]string, len(tokens))
+	for i, token := range tokens {
+		lemmas[i] = LemmatizeWord(str.Word(token))
+	}
+	return lemmas
+}
+
+/**
+ * Приведение слова к начальной форме: местоимения, прилагательные и причастия, существительные.
+ * Аббревиатуры и несклоняемые слова возвращаются без изменений (МГУ, метро), части составного
+ * существительного приводятся к начальной форме по отдельности: диване-кровати - диван-кровать.
+ * Если ни один разбор не подтверждается склонением, слово возвращается как есть.
+ * @param string $word
+ * @return string
+ */
+func LemmatizeWord(w str.Word) string {
+	if declension.IsAbbreviation(w) {
+		return w.String()
+	}
+
+	w = w.Lower()
+	if !isCyrillic(w) || functionWords.Has(w) {
+		return w.String()
+	}
+
+	if lemma, has := pronoun.GetLemma(w); has {
+		return lemma
+	}
+
+	if declension.IsCompound(w) {
+		return lemmatizeCompound(w)
+	}
+	if declension.IsKnownLemma(w) && !declension.IsMutable(w, false) {
+		return w.String()
+	}
+
+	// существительное из словаря важнее похожего окончания прилагательного или глагола: окном, дней, недели
+	nounAnalyses := declension.Analyze(w)
+	if len(nounAnalyses) > 0 && declension.IsKnownLemma(str.Word(nounAnalyses[0].Lemma)) {
+		return nounAnalyses[0].Lemma
+	}
+	if isVerbForm(w) {
+		return w.String()
+	}
+
+	if isAdjectiveForm(w) {
+		if analyses := adjective.Analyze(w); len(analyses) > 0 {
+			return analyses[0].Lemma
+		}
+	}
+
+	for _, analysis := range nounAnalyses {
+		if isNounAnalysisConfirmed(analysis, w.String()) {
+			return analysis.Lemma
+		}
+	}
+	return w.String()
+}
+
+/**
+ * Приведение к начальной форме частей составного слова: диване-кровати - диван-кровать.
+ * Часть после предлога не изменяется: Ростове-на-Дону - ростов-на-дону.
+ * @param string $word
+ * @return string
+ */
+func lemmatizeCompound(w str.Word) string {
+	parts := strings.Split(w.String(), "-")
+	for i, part := range parts {
+		if functionWords.HasStr(part) {
+			break
+		}
+		parts[i] = LemmatizeWord(str.Word(part))
+	}
+	return strings.Join(parts, "-")
+}
+
+/**
+ * Проверка разбора склонением: форма начальной формы в найденных падеже и числе совпадает со словом.
+ * @param Analysis $analysis
+ * @param string $word
+ * @return bool
+ */
+func isNounAnalysisConfirmed(analysis declension.Analysis, form string) bool {
+	lemma := str.Word(analysis.Lemma)
+	for _, animateness := range []bool{false, true} {
+		forms := declension.GetCases(lemma, animateness)
+		if analysis.Number == number.Plural {
+			forms = declension.GetPluralCases(lemma, animateness)
+		}
+		if forms[analysis.Case] == form {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Разбиение текста на слова. Дефис внутри слова сохраняется: кто-то, из-за.
+ * @param string $text
+ * @return string[]
+ */
+func Tokenize(text string) []string {
+	tokens := []string{}
+	runes := []rune(text)
+
+	start := -1
+	for i, r := range runes {
+		isPart := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if r == '-' && start >= 0 && i+1 < len(runes) && unicode.IsLetter(runes[i+1]) {
+			isPart = true
+		}
+
+		switch {
+		case isPart && start < 0:
+			start = i
+		case !isPart && start >= 0:
+			tokens = append(tokens, string(runes[start:i]))
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, string(runes[start:]))
+	}
+	return tokens
+}
+
+/**
+ * Проверка, похоже ли слово на форму прилагательного.
+ * @param string $word
+ * @return bool
+ */
+func isAdjectiveForm(w str.Word) bool {
+	for _, ending := range adjectiveEndings {
+		endingLen := len([]rune(ending))
+		if w.Len() > endingLen+1 && w.EndsWith(endingLen, ending) {
+			return true
+		}
+	}
+
+	for _, ending := range ambiguousEndings {
+		endingLen := len([]rune(ending))
+		if w.Len() <= endingLen+1 || !w.EndsWith(endingLen, ending) {
+			continue
+		}
+		stem := w.SliceWord(0, w.Len()-endingLen)
+		if stem.EndsWith(2, adjectiveSuffixes...) ||
+			(stem.EndsWith(1, "н") && russian.IsConsonant(stem.Chars(-2, -1))) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Проверка, похоже ли слово на форму глагола.
+ * @param string $word
+ * @return bool
+ */
+func isVerbForm(w str.Word) bool {
+	for _, ending := range verbEndings {
+		endingLen := len([]rune(ending))
+		if w.Len() > endingLen+1 && w.EndsWith(endingLen, ending) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * @param string $word
+ * @return bool
+ */
+func isCyrillic(w str.Word) bool {
+	for _, r := range w {
+		if r != '-' && !unicode.Is(unicode.Cyrillic, r) {
+			return false
+		}
+	}
+	return strings.TrimSpace(w.String()) != ""
+}
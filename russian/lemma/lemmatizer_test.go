@@ -0,0 +1,100 @@
+package lemma
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+func Test_Tokenize(t *testing.T) {
+	assert.Equal(t,
+		[]string{"Кто-то", "пришёл", "из-за", "стола", "в", "5", "часов"},
+		Tokenize("Кто-то пришёл из-за стола - в 5 часов!"))
+	assert.Empty(t, Tokenize(" ,.- "))
+}
+
+func Test_Lemmatize(t *testing.T) {
+	tests := []struct {
+		Text   string
+		Lemmas []string
+	}{
+		{
+			Text:   "В новых домах нет окон",
+			Lemmas: []string{"в", "новый", "дом", "нет", "окно"},
+		},
+		{
+			Text:   "Коридорами к большому дому",
+			Lemmas: []string{"коридор", "к", "большой", "дом"},
+		},
+		{
+			Text:   "Заказы пользователей и новости о нём",
+			Lemmas: []string{"заказ", "пользователь", "и", "новость", "о", "он"},
+		},
+		{
+			Text:   "Мы говорили с людьми",
+			Lemmas: []string{"мы", "говорили", "с", "человек"},
+		},
+		{
+			Text:   "iPhone 12 Pro",
+			Lemmas: []string{"iphone", "12", "pro"},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Text, func(t *testing.T) {
+			assert.Equal(t, tst.Lemmas, Lemmatize(tst.Text))
+		})
+	}
+}
+
+func Test_LemmatizeWord(t *testing.T) {
+	tests := []struct {
+		Word  string
+		Lemma string
+	}{
+		{"машину", "машина"},
+		{"большом", "большой"},
+		{"гуляли", "гуляли"},
+		{"недели", "неделя"},
+		{"Вчера", "вчера"},
+		{"домой", "домой"},
+		{"МГУ", "МГУ"},
+		{"ООН", "ООН"},
+		{"кофе", "кофе"},
+		{"диване-кровати", "диван-кровать"},
+		{"Ростове-на-Дону", "ростов-на-дону"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Word, func(t *testing.T) {
+			assert.Equal(t, tst.Lemma, LemmatizeWord(str.Word(tst.Word)))
+		})
+	}
+}
+
+func Test_LemmatizeWord_Paradigm(t *testing.T) {
+	for _, lemma := range []string{"день", "окно", "Москва", "задача", "время", "деньги", "сутки", "стол", "кухня", "ночь"} {
+		w := str.Word(lemma)
+		forms := []string{}
+		for _, caseForms := range []map[cases.Case]string{
+			declension.GetCases(w, false),
+			declension.GetPluralCases(w, false),
+		} {
+			for _, form := range caseForms {
+				forms = append(forms, form)
+			}
+		}
+
+		for _, form := range forms {
+			t.Run(form, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					assert.Equal(t, w.Lower().String(), LemmatizeWord(str.Word(form)))
+				})
+			})
+		}
+	}
+}
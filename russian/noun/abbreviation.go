@@ -0,0 +1,114 @@
+package declension
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Проверка, является ли слово аббревиатурой: аббревиатура из словаря (МГУ, МИД, ЗАГС) или короткое слово
+ * из заглавных русских букв, которое нельзя прочитать как обычное слово: без гласных (РЖД, ВДНХ)
+ * или со стечением трех согласных (МГТУ). Слова вроде ДОМ, МАМА аббревиатурами не считаются.
+ * @param string $word
+ * @return bool
+ */
+func IsAbbreviation(w str.Word) bool {
+	if _, has := abbreviationGenders[w.String()]; has {
+		return true
+	}
+	if w.Len() < 2 || w.Len() > maxAbbreviationLength {
+		return false
+	}
+	for _, r := range w {
+		if !unicode.IsUpper(r) || !unicode.Is(unicode.Cyrillic, r) {
+			return false
+		}
+	}
+	return isUnpronounceable(w.Lower())
+}
+
+/**
+ * Проверка, что слово не читается как обычное слово: в нем нет гласных или есть три согласных подряд.
+ * @param string $word
+ * @return bool
+ */
+func isUnpronounceable(w str.Word) bool {
+	if !hasVowel(w) {
+		return true
+	}
+	consonants := 0
+	for i := 0; i < w.Len(); i++ {
+		if !russian.IsConsonant(w.Chars(i, i+1)) {
+			consonants = 0
+			continue
+		}
+		consonants++
+		if consonants == 3 {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Проверка, является ли аббревиатура звуковой (читается как слово): МИД, ЗАГС, ВУЗ.
+ * Буквенные аббревиатуры (ФСБ, МГУ, США) читаются по названиям букв.
+ * @param string $word
+ * @return bool
+ */
+func isSoundAbbreviation(w str.Word) bool {
+	w = w.Lower()
+	return hasVowel(w.SliceWord(0, -1)) && russian.IsConsonant(w.LastChars(1))
+}
+
+/**
+ * Определение рода аббревиатуры по главному слову: МГУ (университет) - мужской, ООН (организация) - женский.
+ * Звуковые аббревиатуры, которых нет в словаре, считаются словами мужского рода: МИД, ВУЗ.
+ * @param string $word
+ * @return string
+ */
+func GetAbbreviationGender(w str.Word) gender.Gender {
+	if gendr, has := abbreviationGenders[w.String()]; has {
+		return gendr
+	}
+	return gender.Male
+}
+
+/**
+ * Проверка, склоняется ли аббревиатура.
+ * Склоняются только звуковые аббревиатуры мужского рода: МИД - МИДа, ЗАГС - ЗАГСе.
+ * @param string $word
+ * @return bool
+ */
+func IsAbbreviationMutable(w str.Word) bool {
+	return isSoundAbbreviation(w) && GetAbbreviationGender(w) == gender.Male
+}
+
+/**
+ * Склонение аббревиатуры: окончание пишется строчными буквами (МИДа, МИДом).
+ * @param string $word
+ * @param bool $animateness
+ * @param callable $declinate Склонение слова (единственное или множественное число)
+ * @return string[]
+ */
+func getAbbreviationCases(w str.Word, animateness bool, declinate func(str.Word, bool) map[cases.Case]string) map[cases.Case]string {
+	if !IsAbbreviationMutable(w) {
+		return cases.NewCasesWord(w)
+	}
+
+	lower := w.Lower().String()
+	result := cases.NewCases()
+	for cCase, form := range declinate(w.Lower(), animateness) {
+		if strings.HasPrefix(form, lower) {
+			result[cCase] = w.String() + strings.TrimPrefix(form, lower)
+		} else {
+			result[cCase] = str.ApplyCase(w, form)
+		}
+	}
+	return result
+}
@@ -0,0 +1,126 @@
+package declension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+func Test_IsAbbreviation(t *testing.T) {
+	assert.True(t, IsAbbreviation(str.Word("МГУ")))
+	assert.True(t, IsAbbreviation(str.Word("ЗАГС")))
+	assert.True(t, IsAbbreviation(str.Word("ГИБДД")))
+	assert.False(t, IsAbbreviation(str.Word("вуз")))
+	assert.False(t, IsAbbreviation(str.Word("Москва")))
+	assert.False(t, IsAbbreviation(str.Word("ГОРОД")))
+	assert.True(t, IsAbbreviation(str.Word("РЖД")))
+	assert.True(t, IsAbbreviation(str.Word("МГТУ")))
+	assert.False(t, IsAbbreviation(str.Word("ДОМ")))
+	assert.False(t, IsAbbreviation(str.Word("СТОЛ")))
+	assert.False(t, IsAbbreviation(str.Word("МАМА")))
+}
+
+func Test_GetAbbreviationGender(t *testing.T) {
+	assert.Equal(t, gender.Male, DetectGender(str.Word("МГУ")))
+	assert.Equal(t, gender.Female, DetectGender(str.Word("ООН")))
+	assert.Equal(t, gender.Neuter, DetectGender(str.Word("ООО")))
+	assert.Equal(t, gender.Male, DetectGender(str.Word("ОМОН")))
+	assert.Equal(t, gender.Female, DetectGender(str.Word("МАМА")))
+}
+
+func Test_GetCases_Abbreviation(t *testing.T) {
+	tests := []struct {
+		Word  string
+		Cases map[cases.Case]string
+	}{
+		{
+			Word: "МИД",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "МИД",
+				cases.Rodit:   "МИДа",
+				cases.Dat:     "МИДу",
+				cases.Vinit:   "МИД",
+				cases.Tvorit:  "МИДом",
+				cases.Predloj: "МИДе",
+			},
+		},
+		{
+			Word: "ЗАГС",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "ЗАГС",
+				cases.Rodit:   "ЗАГСа",
+				cases.Dat:     "ЗАГСу",
+				cases.Vinit:   "ЗАГС",
+				cases.Tvorit:  "ЗАГСом",
+				cases.Predloj: "ЗАГСе",
+			},
+		},
+		{
+			Word: "вуз",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "вуз",
+				cases.Rodit:   "вуза",
+				cases.Dat:     "вузу",
+				cases.Vinit:   "вуз",
+				cases.Tvorit:  "вузом",
+				cases.Predloj: "вузе",
+			},
+		},
+		{
+			Word: "ДОМ",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "ДОМ",
+				cases.Rodit:   "ДОМА",
+				cases.Dat:     "ДОМУ",
+				cases.Vinit:   "ДОМ",
+				cases.Tvorit:  "ДОМОМ",
+				cases.Predloj: "ДОМЕ",
+			},
+		},
+		{
+			Word: "МАМА",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "МАМА",
+				cases.Rodit:   "МАМЫ",
+				cases.Dat:     "МАМЕ",
+				cases.Vinit:   "МАМУ",
+				cases.Tvorit:  "МАМОЙ",
+				cases.Predloj: "МАМЕ",
+			},
+		},
+		{
+			Word:  "МГУ",
+			Cases: cases.NewCasesWord(str.Word("МГУ")),
+		},
+		{
+			Word:  "ФСБ",
+			Cases: cases.NewCasesWord(str.Word("ФСБ")),
+		},
+		{
+			Word:  "США",
+			Cases: cases.NewCasesWord(str.Word("США")),
+		},
+		{
+			Word:  "ООН",
+			Cases: cases.NewCasesWord(str.Word("ООН")),
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Word, func(t *testing.T) {
+			assert.Equal(t, tst.Cases, GetCases(str.Word(tst.Word), false))
+		})
+	}
+}
+
+func Test_GetPluralCases_Abbreviation(t *testing.T) {
+	forms := GetPluralCases(str.Word("ВУЗ"), false)
+	assert.Equal(t, "ВУЗов", forms[cases.Rodit])
+
+	forms = GetPluralCases(str.Word("МГУ"), false)
+	assert.Equal(t, "МГУ", forms[cases.Rodit])
+}
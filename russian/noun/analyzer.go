@@ -0,0 +1,494 @@
+package declension
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Результат разбора словоформы существительного.
+ */
+type Analysis struct {
+	Lemma  string
+	Case   cases.Case
+	Number number.Number
+	Gender gender.Gender
+}
+
+/**
+ * Обращение правил склонения: окончание словоформы и окончания начальных форм,
+ * от которых оно может быть образовано (стола - стол, окна - окно, кошкой - кошка).
+ */
+var lemmaEndingsByInflection = map[string][]string{
+	"":    {"", "а", "о"},
+	"ь":   {"ь", "я", "е"},
+	"а":   {"", "о", "а"},
+	"я":   {"ь", "й", "е", "я", "ё"},
+	"у":   {"", "о", "а"},
+	"ю":   {"я", "ь", "й", "е", "ё"},
+	"е":   {"", "о", "а", "е", "я", "ь", "й"},
+	"и":   {"ь", "а", "я", "й", "е", "", "и"},
+	"ы":   {"", "а"},
+	"о":   {"о"},
+	"ом":  {"", "о"},
+	"ем":  {"ь", "й", "е", ""},
+	"ём":  {"ь", "й", "ё"},
+	"ой":  {"а", "ой", "ый", "ая"},
+	"ей":  {"ь", "я", "а", "е", "ё", "", "ий", "ая", "яя"},
+	"ою":  {"а"},
+	"ею":  {"я", "а"},
+	"ью":  {"ь"},
+	"ов":  {"", "о"},
+	"ев":  {"й", "ь", "е", ""},
+	"ёв":  {"й", "ь"},
+	"ам":  {"", "а", "о", "ь"},
+	"ами": {"", "а", "о", "ь"},
+	"ах":  {"", "а", "о", "ь"},
+	"ям":  {"ь", "я", "й", "е", "ё"},
+	"ями": {"ь", "я", "й", "е", "ё"},
+	"ях":  {"ь", "я", "й", "е", "ё"},
+	"ого": {"ый", "ой", "ое"},
+	"его": {"ий", "ее"},
+	"ому": {"ый", "ой", "ое"},
+	"ему": {"ий", "ее"},
+	"ым":  {"ый", "ой", "ое"},
+	"им":  {"ий", "ее", "ой"},
+	"ых":  {"ый", "ой", "ая", "ое"},
+	"их":  {"ий", "ее", "яя", "ая", "ой"},
+	"ыми": {"ый", "ой", "ая", "ое"},
+	"ими": {"ий", "ее", "яя", "ая", "ой"},
+	"ую":  {"ая"},
+	"юю":  {"яя"},
+	"ая":  {"ая"},
+	"яя":  {"яя"},
+	"ое":  {"ое"},
+	"ее":  {"ее"},
+	"ые":  {"ый", "ой", "ая", "ое"},
+	"ие":  {"ий", "ая", "яя", "ее", "ой"},
+	"ый":  {"ый"},
+	"ий":  {"ий"},
+}
+
+/**
+ * Окончания словоформ в порядке перебора: сначала длинные, чтобы результат разбора не зависел
+ * от порядка обхода таблицы.
+ * @var string[]
+ */
+var inflectionOrder = getInflectionOrder()
+
+var analysisCaseOrder = []cases.Case{cases.Imenit, cases.Rodit, cases.Dat, cases.Vinit, cases.Tvorit, cases.Predloj}
+
+/**
+ * Кандидат в начальные формы и вес правила, по которому он получен.
+ */
+type lemmaCandidate struct {
+	lemma  string
+	weight int
+}
+
+const (
+	weakWeight       = -2 // нулевое окончание без беглой гласной или основа вместо слова: мам - мама, окн
+	identityWeight   = -1 // словоформа совпадает с начальной формой
+	suffixWeight     = 4  // беглая гласная в суффиксе: котята - котёнок, медвежат - медвежонок
+	dictionaryWeight = 5  // начальная форма найдена в словаре: окна - окно
+	exceptionWeight  = 10 // начальная форма найдена в таблице исключений
+)
+
+/**
+ * Морфологический разбор словоформы существительного: кухонь - кухня (родительный, мн. ч.),
+ * коридорами - коридор (творительный, мн. ч.), пути - путь (родительный, дательный, предложный).
+ *
+ * Разбор обращает правила склонения: от словоформы отбрасывается окончание, к основе подбираются
+ * окончания начальной формы (с учетом беглых гласных и таблиц исключений), а каждый кандидат
+ * проверяется склонением. Предпочтение отдается исключениям, затем словам из словаря частотных
+ * существительных и самым длинным окончаниям. Основы, которые не бывают словами (окн, москв),
+ * и варианты, где словоформа совпадает с начальной формой, идут последними.
+ * @param string $word
+ * @return array
+ */
+func Analyze(w str.Word) []Analysis {
+	w = w.Lower()
+	form := w.String()
+
+	best := []Analysis{}
+	bestWeight := identityWeight
+	identity, weak := []Analysis{}, []Analysis{}
+	seen := map[Analysis]struct{}{}
+
+	for _, candidate := range getLemmaCandidates(w) {
+		analyses := analyzeLemma(str.Word(candidate.lemma), form)
+		if len(analyses) == 0 {
+			continue
+		}
+
+		// несклоняемые слова: такси, кофе
+		if candidate.lemma == form && immutableWords.Has(w) {
+			return analyses
+		}
+
+		switch {
+		case candidate.weight == identityWeight:
+			identity = append(identity, analyses...)
+			continue
+		case candidate.weight == weakWeight:
+			weak = append(weak, analyses...)
+			continue
+		case candidate.weight > bestWeight:
+			best = best[:0]
+			bestWeight = candidate.weight
+		case candidate.weight < bestWeight:
+			continue
+		}
+		best = append(best, analyses...)
+	}
+
+	result := []Analysis{}
+	for _, analysis := range append(append(best, identity...), weak...) {
+		if _, has := seen[analysis]; has {
+			continue
+		}
+		seen[analysis] = struct{}{}
+		result = append(result, analysis)
+	}
+	return result
+}
+
+/**
+ * Проверка, известна ли начальная форма по словарю или таблицам исключений: окно, сутки, такси.
+ * Такой результат разбора надежнее, чем выведенный только по окончанию.
+ * @param string $lemma
+ * @return bool
+ */
+func IsKnownLemma(lemma str.Word) bool {
+	lemma = lemma.Lower()
+	return isDictionaryLemma(lemma) || pluraliaTantum.Has(lemma) || immutableWords.Has(lemma)
+}
+
+/**
+ * Поиск словоформы среди форм предполагаемой начальной формы.
+ * @param string $lemma
+ * @param string $form
+ * @return array
+ */
+func analyzeLemma(lemma str.Word, form string) []Analysis {
+	gendr := DetectGender(lemma)
+	result := []Analysis{}
+	seen := map[Analysis]struct{}{}
+	add := func(cCase cases.Case, num number.Number) {
+		analysis := Analysis{Lemma: lemma.String(), Case: cCase, Number: num, Gender: gendr}
+		if _, has := seen[analysis]; !has {
+			seen[analysis] = struct{}{}
+			result = append(result, analysis)
+		}
+	}
+
+	for _, animateness := range []bool{false, true} {
+		// у pluralia tantum только формы множественного числа: сутки, деньги
+		if !pluraliaTantum.Has(lemma) {
+			singular := GetCases(lemma, animateness)
+			for _, cCase := range analysisCaseOrder {
+				if sameForm(singular[cCase], form) {
+					add(cCase, number.Singular)
+				}
+			}
+		}
+
+		if immutableWords.Has(lemma) || singulariaTantum.Has(lemma) {
+			continue
+		}
+		plural := GetPluralCases(lemma, animateness)
+		for _, cCase := range analysisCaseOrder {
+			if sameForm(plural[cCase], form) {
+				add(cCase, number.Plural)
+			}
+		}
+	}
+	return result
+}
+
+/**
+ * Подбор возможных начальных форм для словоформы.
+ * @param string $word
+ * @return array
+ */
+func getLemmaCandidates(w str.Word) []lemmaCandidate {
+	candidates := []lemmaCandidate{}
+	indexes := map[string]int{}
+	add := func(lemma string, weight int) {
+		lemmaWord := str.Word(lemma)
+		if lemmaWord.Len() < 2 || !isLemmaPlausible(lemmaWord) {
+			return
+		}
+		switch {
+		case weight == exceptionWeight:
+		case isDictionaryLemma(lemmaWord):
+			weight = dictionaryWeight
+		case lemma == w.String():
+			weight = identityWeight
+		case isBareStem(lemmaWord):
+			weight = weakWeight
+		}
+		if ind, has := indexes[lemma]; has {
+			if weight > candidates[ind].weight && candidates[ind].weight != identityWeight {
+				candidates[ind].weight = weight
+			}
+			return
+		}
+		indexes[lemma] = len(candidates)
+		candidates = append(candidates, lemmaCandidate{lemma: lemma, weight: weight})
+	}
+
+	form := w.String()
+	exceptions := []string{}
+	if lemma, has := GetAbnormalLemma(w); has {
+		exceptions = append(exceptions, lemma)
+	}
+	for lemma, forms := range pluraliaTantum.Map {
+		if hasForm(forms, form) {
+			exceptions = append(exceptions, lemma)
+		}
+	}
+	for lemma, forms := range pluralAbnormalExceptions.Map {
+		if hasForm(forms, form) {
+			exceptions = append(exceptions, lemma)
+		}
+	}
+	for lemma, genitive := range genitiveExceptions {
+		if genitive == form {
+			exceptions = append(exceptions, lemma)
+		}
+	}
+	// порядок обхода таблиц не определен, а результат разбора должен быть стабильным
+	sort.Strings(exceptions)
+	for _, lemma := range exceptions {
+		add(lemma, exceptionWeight)
+	}
+
+	add(form, identityWeight)
+	for _, ending := range inflectionOrder {
+		lemmaEndings := lemmaEndingsByInflection[ending]
+		endingLen := len([]rune(ending))
+		if w.Len() <= endingLen || !w.EndsWith(endingLen, ending) {
+			continue
+		}
+		stem := w.SliceWord(0, w.Len()-endingLen)
+
+		for _, lemmaEnding := range lemmaEndings {
+			weight := endingLen
+			// родительный падеж множественного числа с нулевым окончанием (мам - мама, недель - неделя)
+			// неотличим от начальной формы, поэтому такие варианты идут после нее
+			if (ending == "" || ending == "ь") && lemmaEnding != "" && lemmaEnding != "ь" {
+				// нулевое окончание у начальных форм на -а, -о возможно только после согласной
+				if ending == "" && !russian.IsConsonant(w.LastChars(1)) {
+					continue
+				}
+				weight = weakWeight
+			}
+			add(stem.Concat(lemmaEnding), weight)
+
+			// беглая гласная: отца - отец, дня - день, окон - окно, ложек - ложка
+			switch {
+			case (lemmaEnding == "" || lemmaEnding == "ь") && ending != "" && ending != "ь":
+				for _, variant := range insertStemVowel(stem) {
+					add(variant+lemmaEnding, endingLen)
+				}
+			case ending == "" && lemmaEnding != "" && russian.IsConsonant(w.LastChars(1)):
+				if variant, has := removeStemVowel(stem); has {
+					add(variant+lemmaEnding, endingLen)
+				}
+			}
+		}
+
+		// имени - имя, времён - время
+		if stem.EndsWith(2, "ен", "ён") {
+			add(stem.Chars(0, -2)+"я", endingLen)
+		}
+		// котята - котёнок, медвежат - медвежонок
+		if stem.EndsWith(2, "ят") {
+			add(stem.Chars(0, -2)+"ёнок", suffixWeight)
+		} else if stem.EndsWith(2, "ат") && russian.IsHissingConsonant(stem.Chars(-3, -2)) {
+			add(stem.Chars(0, -2)+"онок", suffixWeight)
+		}
+	}
+
+	return candidates
+}
+
+/**
+ * Порядок перебора окончаний словоформ: по убыванию длины, затем по алфавиту.
+ * @return string[]
+ */
+func getInflectionOrder() []string {
+	endings := make([]string, 0, len(lemmaEndingsByInflection))
+	for ending := range lemmaEndingsByInflection {
+		endings = append(endings, ending)
+	}
+	sort.Slice(endings, func(i, j int) bool {
+		iLen, jLen := len([]rune(endings[i])), len([]rune(endings[j]))
+		if iLen != jLen {
+			return iLen > jLen
+		}
+		return endings[i] < endings[j]
+	})
+	return endings
+}
+
+/**
+ * Вставка беглой гласной в основу: отц - отец, льв - лев, дн - ден.
+ * @param string $stem
+ * @return string[]
+ */
+func insertStemVowel(stem str.Word) []string {
+	if stem.Len() < 2 {
+		return nil
+	}
+
+	last, prelast := stem.Chars(-1, stem.Len()), stem.Chars(-2, -1)
+	if !russian.IsConsonant(last) {
+		return nil
+	}
+	switch {
+	// льва - лев, бойца - боец
+	case prelast == "ь" || prelast == "й":
+		base := stem.Chars(0, -2)
+		return []string{base + "е" + last, base + "ё" + last}
+	case russian.IsConsonant(prelast):
+		base := stem.Chars(0, -1)
+		return []string{base + "о" + last, base + "е" + last, base + "ё" + last}
+	}
+	return nil
+}
+
+/**
+ * Удаление беглой гласной из основы: окон - окн, ложек - ложк, сестёр - сестр.
+ * @param string $stem
+ * @return string
+ */
+func removeStemVowel(stem str.Word) (string, bool) {
+	if stem.Len() < 3 {
+		return "", false
+	}
+
+	last := stem.Chars(-1, stem.Len())
+	if russian.IsConsonant(last) && stem.SliceWord(-2, -1).OneOf("о", "е", "ё") &&
+		russian.IsConsonant(stem.Chars(-3, -2)) {
+		return stem.Chars(0, -2) + last, true
+	}
+	return "", false
+}
+
+/**
+ * Проверка, есть ли начальная форма в словаре частотных существительных или в списках
+ * слов с особым склонением: окно, день, мебель.
+ * @param string $lemma
+ * @return bool
+ */
+func isDictionaryLemma(lemma str.Word) bool {
+	_, isGenitiveException := genitiveExceptions[lemma.String()]
+	return commonNouns.Has(lemma) || isGenitiveException ||
+		masculineWithSoft.Has(lemma) || masculineWithSoftAndRunAwayVowels.Has(lemma) ||
+		singulariaTantum.Has(lemma) || neuterExceptions.Has(lemma) ||
+		abnormalExceptions.Has(lemma) || pluralAbnormalExceptions.Has(lemma)
+}
+
+/**
+ * Проверка, похожа ли начальная форма с нулевым окончанием на основу другого слова:
+ * окн (окно), москв (Москва), ложк (ложка), отц (отец). Сочетания согласных на конце, с которыми
+ * существительные мужского рода встречаются редко, кроме сочетаний с р, л (шторм, холм, парк).
+ * @param string $lemma
+ * @return bool
+ */
+func isBareStem(lemma str.Word) bool {
+	if lemma.Len() < 3 {
+		return false
+	}
+	last, prelast := lemma.LastChars(1), lemma.Chars(-2, -1)
+	if !russian.IsConsonant(prelast) || prelast == "р" || prelast == "л" {
+		return false
+	}
+	switch last {
+	case "н", "м", "в", "л", "ц":
+		return true
+	case "к":
+		return prelast != "н" && prelast != "с"
+	}
+	return false
+}
+
+/**
+ * Отсев невозможных начальных форм. Начальная форма на -и, -у, -ю возможна
+ * только у несклоняемых слов (такси, кенгуру, меню) и pluralia tantum на -и (сутки, деньги).
+ * @param string $lemma
+ * @return bool
+ */
+func isLemmaPlausible(lemma str.Word) bool {
+	if !hasVowel(lemma) {
+		return false
+	}
+	if lemma.EndsWith(2, "ый", "ий", "ой", "ая", "яя", "ое", "ее") && lemma.Len() < 4 {
+		return false
+	}
+
+	switch lemma.LastChars(1) {
+	case "и", "у", "ю":
+		return immutableWords.Has(lemma) || pluraliaTantum.Has(lemma)
+	// -й пишется только после гласной, -ь - только после согласной, кроме г, к, х
+	case "й":
+		return russian.IsVowel(lemma.Chars(-2, -1))
+	case "ь":
+		prelast := lemma.Chars(-2, -1)
+		return russian.IsConsonant(prelast) && !russian.IsVelarConsonant(prelast)
+	}
+	return true
+}
+
+/**
+ * @param string $word
+ * @return bool
+ */
+func hasVowel(w str.Word) bool {
+	for i := 0; i < w.Len(); i++ {
+		if russian.IsVowel(w.Chars(i, i+1)) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * @param string[] $forms
+ * @param string $form
+ * @return bool
+ */
+func hasForm(forms []string, form string) bool {
+	for _, f := range forms {
+		if f == form {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Сравнение словоформ без учета различия е/ё: сестёр - сестер.
+ * @param string $form
+ * @param string $other
+ * @return bool
+ */
+func sameForm(form, other string) bool {
+	return normalizeYo(form) == normalizeYo(other)
+}
+
+/**
+ * @param string $form
+ * @return string
+ */
+func normalizeYo(form string) string {
+	return strings.Replace(form, "ё", "е", -1)
+}
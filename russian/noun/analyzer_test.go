@@ -0,0 +1,103 @@
+package declension
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Analyze(t *testing.T) {
+	tests := []struct {
+		Word     string
+		Expected Analysis
+	}{
+		{Word: "кухонь", Expected: Analysis{Lemma: "кухня", Case: cases.Rodit, Number: number.Plural, Gender: gender.Female}},
+		{Word: "коридорами", Expected: Analysis{Lemma: "коридор", Case: cases.Tvorit, Number: number.Plural, Gender: gender.Male}},
+		{Word: "пути", Expected: Analysis{Lemma: "путь", Case: cases.Rodit, Number: number.Singular, Gender: gender.Male}},
+		{Word: "пути", Expected: Analysis{Lemma: "путь", Case: cases.Imenit, Number: number.Plural, Gender: gender.Male}},
+		{Word: "стола", Expected: Analysis{Lemma: "стол", Case: cases.Rodit, Number: number.Singular, Gender: gender.Male}},
+		{Word: "дня", Expected: Analysis{Lemma: "день", Case: cases.Rodit, Number: number.Singular, Gender: gender.Male}},
+		{Word: "окон", Expected: Analysis{Lemma: "окно", Case: cases.Rodit, Number: number.Plural, Gender: gender.Neuter}},
+		{Word: "ложек", Expected: Analysis{Lemma: "ложка", Case: cases.Rodit, Number: number.Plural, Gender: gender.Female}},
+		{Word: "кошкой", Expected: Analysis{Lemma: "кошка", Case: cases.Tvorit, Number: number.Singular, Gender: gender.Female}},
+		{Word: "имени", Expected: Analysis{Lemma: "имя", Case: cases.Rodit, Number: number.Singular, Gender: gender.Neuter}},
+		{Word: "людей", Expected: Analysis{Lemma: "человек", Case: cases.Rodit, Number: number.Plural, Gender: gender.Male}},
+		{Word: "рабочего", Expected: Analysis{Lemma: "рабочий", Case: cases.Rodit, Number: number.Singular, Gender: gender.Male}},
+		{Word: "котята", Expected: Analysis{Lemma: "котёнок", Case: cases.Imenit, Number: number.Plural, Gender: gender.Male}},
+		{Word: "суток", Expected: Analysis{Lemma: "сутки", Case: cases.Rodit, Number: number.Plural, Gender: DetectGender(str.Word("сутки"))}},
+		{Word: "деньгами", Expected: Analysis{Lemma: "деньги", Case: cases.Tvorit, Number: number.Plural, Gender: DetectGender(str.Word("деньги"))}},
+		{Word: "Стол", Expected: Analysis{Lemma: "стол", Case: cases.Imenit, Number: number.Singular, Gender: gender.Male}},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Word, func(t *testing.T) {
+			assert.Contains(t, Analyze(str.Word(tst.Word)), tst.Expected)
+		})
+	}
+}
+
+func Test_Analyze_Order(t *testing.T) {
+	analyses := Analyze(str.Word("коридорами"))
+	require.NotEmpty(t, analyses)
+	assert.Equal(t, "коридор", analyses[0].Lemma)
+
+	analyses = Analyze(str.Word("стол"))
+	require.NotEmpty(t, analyses)
+	assert.Equal(t, Analysis{Lemma: "стол", Case: cases.Imenit, Number: number.Singular, Gender: gender.Male}, analyses[0])
+
+	for _, analysis := range Analyze(str.Word("такси")) {
+		assert.Equal(t, "такси", analysis.Lemma)
+	}
+}
+
+func Test_Analyze_FirstLemma(t *testing.T) {
+	tests := []struct {
+		Word  string
+		Lemma string
+	}{
+		{"день", "день"},
+		{"дню", "день"},
+		{"окна", "окно"},
+		{"окну", "окно"},
+		{"окнам", "окно"},
+		{"Москвы", "москва"},
+		{"Москве", "москва"},
+		{"задачи", "задача"},
+		{"задачей", "задача"},
+		{"времени", "время"},
+		{"денег", "деньги"},
+		{"деньги", "деньги"},
+		{"суток", "сутки"},
+		{"сутки", "сутки"},
+		{"ночам", "ночь"},
+		{"отца", "отец"},
+		{"концом", "конец"},
+		{"огня", "огонь"},
+		{"ветра", "ветер"},
+		{"котятами", "котёнок"},
+		{"медвежат", "медвежонок"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Word, func(t *testing.T) {
+			var analyses []Analysis
+			require.NotPanics(t, func() { analyses = Analyze(str.Word(tst.Word)) })
+			require.NotEmpty(t, analyses)
+			assert.Equal(t, tst.Lemma, analyses[0].Lemma)
+		})
+	}
+}
+
+func Test_Analyze_NumberRestrictions(t *testing.T) {
+	for _, analysis := range Analyze(str.Word("сутки")) {
+		assert.Equal(t, number.Plural, analysis.Number)
+	}
+	for _, analysis := range Analyze(str.Word("мебели")) {
+		assert.Equal(t, number.Singular, analysis.Number)
+	}
+}
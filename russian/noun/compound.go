@@ -0,0 +1,95 @@
+package declension
+
+import (
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Проверка, является ли существительное составным (пишется через дефис): диван-кровать, интернет-магазин.
+ * @param string $word
+ * @return bool
+ */
+func IsCompound(w str.Word) bool {
+	parts := strings.Split(w.String(), "-")
+	if len(parts) < 2 {
+		return false
+	}
+	for _, part := range parts {
+		if part == "" {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+ * Склонение составного существительного по частям.
+ * Склоняются:
+ * - только первая часть, если за ней идёт предлог: Ростов-на-Дону - Ростова-на-Дону;
+ * - только последняя часть, если первая несклоняемая: интернет-магазин, кафе-бар;
+ * - обе части в остальных случаях: диван-кровать - дивана-кровати, плащ-палатка - плащом-палаткой.
+ * @param string $word
+ * @param bool $animateness
+ * @param callable $declinate Склонение одной части (единственное или множественное число)
+ * @return string[]
+ */
+func getCompoundCases(w str.Word, animateness bool, declinate func(str.Word, bool) map[cases.Case]string) map[cases.Case]string {
+	parts := strings.Split(w.String(), "-")
+	mutable := getCompoundMutableParts(parts)
+
+	result := cases.NewCases()
+	for ind, part := range parts {
+		forms := cases.NewCasesWord(str.Word(part))
+		if mutable[ind] {
+			forms = declinate(str.Word(part), animateness)
+		}
+		for cCase, form := range forms {
+			if ind == 0 {
+				result[cCase] = form
+			} else {
+				result[cCase] += "-" + form
+			}
+		}
+	}
+	return result
+}
+
+/**
+ * Определение склоняемых частей составного существительного.
+ * @param string[] $parts
+ * @return bool[]
+ */
+func getCompoundMutableParts(parts []string) []bool {
+	mutable := make([]bool, len(parts))
+
+	// Ростов-на-Дону, Франкфурт-на-Майне
+	if len(parts) > 2 && compoundPrepositions.HasStr(parts[1]) {
+		mutable[0] = true
+		return mutable
+	}
+
+	last := len(parts) - 1
+	mutable[last] = IsMutable(str.Word(parts[last]), false)
+	for ind := 0; ind < last; ind++ {
+		part := str.Word(parts[ind])
+		mutable[ind] = !fixedFirstParts.Has(part) && IsMutable(part, false) && !isForeignWord(part)
+	}
+	return mutable
+}
+
+/**
+ * Часть, записанная латиницей (iPhone-чехол), не склоняется.
+ * @param string $part
+ * @return bool
+ */
+func isForeignWord(part str.Word) bool {
+	for _, r := range part {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return true
+		}
+	}
+	return false
+}
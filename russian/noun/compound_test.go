@@ -0,0 +1,94 @@
+package declension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+func Test_IsCompound(t *testing.T) {
+	assert.True(t, IsCompound(str.Word("диван-кровать")))
+	assert.True(t, IsCompound(str.Word("Ростов-на-Дону")))
+	assert.False(t, IsCompound(str.Word("диван")))
+	assert.False(t, IsCompound(str.Word("диван-")))
+}
+
+func Test_GetCases_Compound(t *testing.T) {
+	tests := []struct {
+		Word  string
+		Cases map[cases.Case]string
+	}{
+		{
+			Word: "диван-кровать",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "диван-кровать",
+				cases.Rodit:   "дивана-кровати",
+				cases.Dat:     "дивану-кровати",
+				cases.Vinit:   "диван-кровать",
+				cases.Tvorit:  "диваном-кроватью",
+				cases.Predloj: "диване-кровати",
+			},
+		},
+		{
+			Word: "плащ-палатка",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "плащ-палатка",
+				cases.Rodit:   "плаща-палатки",
+				cases.Dat:     "плащу-палатке",
+				cases.Vinit:   "плащ-палатку",
+				cases.Tvorit:  "плащом-палаткой",
+				cases.Predloj: "плаще-палатке",
+			},
+		},
+		{
+			Word: "кафе-бар",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "кафе-бар",
+				cases.Rodit:   "кафе-бара",
+				cases.Dat:     "кафе-бару",
+				cases.Vinit:   "кафе-бар",
+				cases.Tvorit:  "кафе-баром",
+				cases.Predloj: "кафе-баре",
+			},
+		},
+		{
+			Word: "интернет-магазин",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "интернет-магазин",
+				cases.Rodit:   "интернет-магазина",
+				cases.Dat:     "интернет-магазину",
+				cases.Vinit:   "интернет-магазин",
+				cases.Tvorit:  "интернет-магазином",
+				cases.Predloj: "интернет-магазине",
+			},
+		},
+		{
+			Word: "Ростов-на-Дону",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "Ростов-на-Дону",
+				cases.Rodit:   "Ростова-на-Дону",
+				cases.Dat:     "Ростову-на-Дону",
+				cases.Vinit:   "Ростов-на-Дону",
+				cases.Tvorit:  "Ростовом-на-Дону",
+				cases.Predloj: "Ростове-на-Дону",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Word, func(t *testing.T) {
+			assert.Equal(t, tst.Cases, GetCases(str.Word(tst.Word), false))
+		})
+	}
+}
+
+func Test_GetPluralCases_Compound(t *testing.T) {
+	forms := GetPluralCases(str.Word("бизнес-план"), false)
+	assert.Equal(t, "бизнес-планов", forms[cases.Rodit])
+
+	forms = GetPluralCases(str.Word("вагон-ресторан"), false)
+	assert.Equal(t, "вагонами-ресторанами", forms[cases.Tvorit])
+}
@@ -1,6 +1,9 @@
 package declension
 
-import "github.com/dshipenok/gomorphos/str"
+import (
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/str"
+)
 
 const (
 	FirstDeclension  = 1
@@ -15,10 +18,12 @@ var immutableWords = str.NewWordSet([]string{
 	"боа", "бра", "фейхоа", "амплуа", "буржуа",
 	// на о
 	"манго", "какао", "кино", "трюмо", "пальто", "бюро", "танго", "вето", "бунгало", "сабо", "авокадо", "депо", "панно",
+	"метро", "фото", "казино", "домино", "лото", "пианино", "кимоно", "эскимо", "табло", "бистро", "ранчо", "сальто",
+	"пончо", "банджо", "болеро", "сомбреро", "гетто", "либретто", "жабо", "кашпо", "эго", "кило",
 	// на у
 	"зебу", "кенгуру", "рагу", "какаду", "шоу",
 	// на е
-	"шимпанзе", "конферансье", "атташе", "колье", "резюме", "пенсне", "кашне", "протеже", "коммюнике", "драже", "суфле", "пюре", "купе", "фойе", "шоссе", "крупье",
+	"шимпанзе", "кафе", "кофе", "конферансье", "атташе", "колье", "резюме", "пенсне", "кашне", "протеже", "коммюнике", "драже", "суфле", "пюре", "купе", "фойе", "шоссе", "крупье",
 	// на и
 	"такси", "жалюзи", "шасси", "алиби", "киви", "иваси", "регби", "конфетти", "колибри", "жюри", "пенальти", "рефери", "кольраби",
 	// на э
@@ -51,6 +56,7 @@ var masculineWithSoft = str.NewWordSet([]string{
 	"врач",
 	"выхухоль",
 	"гвоздь",
+	"гость",
 	"делопроизводитель",
 	"дождь",
 	"заместитель",
@@ -84,6 +90,16 @@ var masculineWithSoft = str.NewWordSet([]string{
 	"шкворень",
 	"юань",
 	"ячмень",
+	// месяцы
+	"январь",
+	"февраль",
+	"апрель",
+	"июнь",
+	"июль",
+	"сентябрь",
+	"октябрь",
+	"ноябрь",
+	"декабрь",
 })
 
 /** @var string[]  */
@@ -94,4 +110,203 @@ var masculineWithSoftAndRunAwayVowels = str.NewWordSet([]string{
 	"камень",
 	"корень",
 	"трутень",
+	"огонь",
+})
+
+/**
+ * Существительные мужского рода с беглой гласной, которые не описываются суффиксами -ок, -бец.
+ * Значение - основа косвенных падежей: отец - отца, ветер - ветра.
+ */
+var masculineWithRunAwayVowels = str.NewWordMap(map[string][]string{
+	"отец":  {"отц"},
+	"конец": {"конц"},
+	"ветер": {"ветр"},
+})
+
+/**
+ * Существительные второго склонения на шипящую с ударным окончанием: в творительном падеже -ом (плащом, ножом).
+ * @var string[]
+ */
+var stressedHissingNouns = str.NewWordSet([]string{
+	"плащ", "нож", "врач", "меч", "ключ", "луч", "мяч", "грач", "калач", "кирпич", "москвич", "палач",
+	"богач", "силач", "трубач", "скрипач", "хрящ", "клещ", "лещ", "борщ", "этаж", "багаж", "гараж", "тираж",
+})
+
+/**
+ * Формы множественного числа, не выводимые по правилам.
+ * Порядок: именительный, родительный, дательный, винительный, творительный, предложный.
+ */
+var pluralAbnormalExceptions = str.NewWordMap(map[string][]string{
+	"человек": {"люди", "людей", "людям", "людей", "людьми", "людях"},
+	"ребенок": {"дети", "детей", "детям", "детей", "детьми", "детях"},
+	"ребёнок": {"дети", "детей", "детям", "детей", "детьми", "детях"},
+	"дитя":    {"дети", "детей", "детям", "детей", "детьми", "детях"},
+	"друг":    {"друзья", "друзей", "друзьям", "друзей", "друзьями", "друзьях"},
+	"брат":    {"братья", "братьев", "братьям", "братьев", "братьями", "братьях"},
+	"сын":     {"сыновья", "сыновей", "сыновьям", "сыновей", "сыновьями", "сыновьях"},
+	"мать":    {"матери", "матерей", "матерям", "матерей", "матерями", "матерях"},
+	"дочь":    {"дочери", "дочерей", "дочерям", "дочерей", "дочерьми", "дочерях"},
+	"стул":    {"стулья", "стульев", "стульям", "стулья", "стульями", "стульях"},
+	"дерево":  {"деревья", "деревьев", "деревьям", "деревья", "деревьями", "деревьях"},
+	"перо":    {"перья", "перьев", "перьям", "перья", "перьями", "перьях"},
+	"ухо":     {"уши", "ушей", "ушам", "уши", "ушами", "ушах"},
+	"глаз":    {"глаза", "глаз", "глазам", "глаза", "глазами", "глазах"},
+	"дом":     {"дома", "домов", "домам", "дома", "домами", "домах"},
+	"город":   {"города", "городов", "городам", "города", "городами", "городах"},
+	"адрес":   {"адреса", "адресов", "адресам", "адреса", "адресами", "адресах"},
+	"вечер":   {"вечера", "вечеров", "вечерам", "вечера", "вечерами", "вечерах"},
+	"номер":   {"номера", "номеров", "номерам", "номера", "номерами", "номерах"},
+	"паспорт": {"паспорта", "паспортов", "паспортам", "паспорта", "паспортами", "паспортах"},
+	"счет":    {"счета", "счетов", "счетам", "счета", "счетами", "счетах"},
+	"счёт":    {"счета", "счетов", "счетам", "счета", "счетами", "счетах"},
+	"год":     {"годы", "лет", "годам", "годы", "годами", "годах"},
+	"путь":    {"пути", "путей", "путям", "пути", "путями", "путях"},
+	"небо":    {"небеса", "небес", "небесам", "небеса", "небесами", "небесах"},
+	"чудо":    {"чудеса", "чудес", "чудесам", "чудеса", "чудесами", "чудесах"},
+	"лошадь":  {"лошади", "лошадей", "лошадям", "лошадей", "лошадьми", "лошадях"},
+	"дверь":   {"двери", "дверей", "дверям", "двери", "дверьми", "дверях"},
+})
+
+/** @var string[] Существительные среднего рода на -е с окончанием -ей в родительном падеже */
+var neuterExceptions = str.NewWordSet([]string{
+	"поле",
+	"море",
+})
+
+/** @var string[] Формы родительного падежа множественного числа */
+var genitiveExceptions = map[string]string{
+	"письмо":    "писем",
+	"кресло":    "кресел",
+	"коромысло": "коромысел",
+	"гривна":    "гривен",
+	"кухня":     "кухонь",
+	"деревня":   "деревень",
+	"семя":      "семян",
+	"стремя":    "стремян",
+	"утро":      "утр",
+	// единицы измерения со счетной формой без окончания: пять ватт, десять вольт
+	"ватт":     "ватт",
+	"киловатт": "киловатт",
+	"вольт":    "вольт",
+	"ампер":    "ампер",
+	"герц":     "герц",
+}
+
+// формы числительного для согласования с существительным
+const (
+	One       = 1 // 1, 21, 101: одна задача
+	TwoFour   = 2 // 2-4, 22-24: две задачи
+	FiveOther = 3 // 0, 5-20, 25-30: пять задач
+)
+
+/** @var string[] Несклоняемые первые части составных существительных: интернет-магазин, бизнес-план */
+var fixedFirstParts = str.NewWordSet([]string{
+	"интернет", "бизнес", "веб", "онлайн", "офлайн", "офис", "пресс", "премьер", "вице", "экс", "лайф",
+	"мини", "макси", "шоу", "фитнес", "спорт", "арт", "поп", "рок", "блиц", "топ", "штаб",
+	"контр", "унтер", "обер", "лейб", "медиа", "стоп", "эконом", "экспресс", "фото", "видео", "аудио",
+})
+
+/** @var string[] Предлоги внутри составных названий: Ростов-на-Дону, Франкфурт-на-Майне */
+var compoundPrepositions = str.NewWordSet([]string{
+	"на", "в", "во", "под", "над", "за",
+})
+
+/** @var string[] Род аббревиатур по главному слову: МГУ - университет, ООН - организация */
+var abbreviationGenders = map[string]gender.Gender{
+	// мужской род
+	"МГУ": gender.Male, "МИД": gender.Male, "ЗАГС": gender.Male, "ВУЗ": gender.Male, "ГУМ": gender.Male,
+	"ЦУМ": gender.Male, "МХАТ": gender.Male, "ЕГЭ": gender.Male, "ИП": gender.Male, "НИИ": gender.Male,
+	"ТЮЗ": gender.Male, "ОВИР": gender.Male, "БАМ": gender.Male, "ДОТ": gender.Male, "ЖЭК": gender.Male,
+	// женский род
+	"ООН": gender.Female, "ФСБ": gender.Female, "ГЭС": gender.Female, "АЭС": gender.Female, "ТЭЦ": gender.Female,
+	"РФ": gender.Female, "НАТО": gender.Female, "ГИБДД": gender.Female, "АТС": gender.Female,
+	// средний род; сюда же отнесены аббревиатуры, согласуемые во множественном числе (США)
+	"США": gender.Neuter, "ООО": gender.Neuter, "ОАО": gender.Neuter, "ЗАО": gender.Neuter, "ПАО": gender.Neuter, "АО": gender.Neuter,
+	"ЦРУ": gender.Neuter, "МВД": gender.Neuter, "СНГ": gender.Neuter, "ТАСС": gender.Neuter, "НКО": gender.Neuter, "МЧС": gender.Neuter,
+}
+
+/** @var int Максимальная длина слова в верхнем регистре, которое может считаться аббревиатурой вне словаря */
+const maxAbbreviationLength = 4
+
+/** @var string[] Иноязычные женские имена на согласный, которые не склоняются */
+var foreignFemaleNames = str.NewWordSet([]string{
+	"кармен", "ирэн", "элизабет", "маргарет", "жаклин", "ингрид", "рут", "эстер", "дженнифер", "кэтрин",
+	"мэрилин", "кейт", "шарлотт", "аннет", "жизель", "николь", "изабель", "эдит", "эллен",
+	"хелен", "сьюзен", "кэрол", "рэйчел", "айгуль", "гульнар", "асель", "зейнаб", "мадлен",
+})
+
+/** @var string[] Иноязычные имена собственные на -о, -е, которые не склоняются: Осло, Монако, Гёте */
+var foreignProperNames = str.NewWordSet([]string{
+	// топонимы
+	"осло", "монако", "чикаго", "торонто", "мехико", "сантьяго", "сорренто", "палермо", "лимпопо",
+	"колорадо", "бордо", "бамако", "тобаго",
+	// фамилии и имена
+	"гёте", "гете", "шевченко", "франко", "пикассо", "гюго", "доде", "мане", "моне", "руссо", "бруно", "отелло",
+})
+
+/**
+ * Существительные, имеющие только множественное число (pluralia tantum).
+ * Порядок: именительный, родительный, дательный, винительный, творительный, предложный.
+ */
+var pluraliaTantum = str.NewWordMap(map[string][]string{
+	"ножницы":  {"ножницы", "ножниц", "ножницам", "ножницы", "ножницами", "ножницах"},
+	"брюки":    {"брюки", "брюк", "брюкам", "брюки", "брюками", "брюках"},
+	"сутки":    {"сутки", "суток", "суткам", "сутки", "сутками", "сутках"},
+	"деньги":   {"деньги", "денег", "деньгам", "деньги", "деньгами", "деньгах"},
+	"очки":     {"очки", "очков", "очкам", "очки", "очками", "очках"},
+	"ворота":   {"ворота", "ворот", "воротам", "ворота", "воротами", "воротах"},
+	"сани":     {"сани", "саней", "саням", "сани", "санями", "санях"},
+	"джинсы":   {"джинсы", "джинсов", "джинсам", "джинсы", "джинсами", "джинсах"},
+	"шорты":    {"шорты", "шорт", "шортам", "шорты", "шортами", "шортах"},
+	"весы":     {"весы", "весов", "весам", "весы", "весами", "весах"},
+	"грабли":   {"грабли", "грабель", "граблям", "грабли", "граблями", "граблях"},
+	"вилы":     {"вилы", "вил", "вилам", "вилы", "вилами", "вилах"},
+	"щипцы":    {"щипцы", "щипцов", "щипцам", "щипцы", "щипцами", "щипцах"},
+	"духи":     {"духи", "духов", "духам", "духи", "духами", "духах"},
+	"обои":     {"обои", "обоев", "обоям", "обои", "обоями", "обоях"},
+	"дрожжи":   {"дрожжи", "дрожжей", "дрожжам", "дрожжи", "дрожжами", "дрожжах"},
+	"каникулы": {"каникулы", "каникул", "каникулам", "каникулы", "каникулами", "каникулах"},
+	"именины":  {"именины", "именин", "именинам", "именины", "именинами", "именинах"},
+	"похороны": {"похороны", "похорон", "похоронам", "похороны", "похоронами", "похоронах"},
+	"сливки":   {"сливки", "сливок", "сливкам", "сливки", "сливками", "сливках"},
+	"ясли":     {"ясли", "яслей", "яслям", "ясли", "яслями", "яслях"},
+	"носилки":  {"носилки", "носилок", "носилкам", "носилки", "носилками", "носилках"},
+	"качели":   {"качели", "качелей", "качелям", "качели", "качелями", "качелях"},
+	"шахматы":  {"шахматы", "шахмат", "шахматам", "шахматы", "шахматами", "шахматах"},
+	"перила":   {"перила", "перил", "перилам", "перила", "перилами", "перилах"},
+	"чернила":  {"чернила", "чернил", "чернилам", "чернила", "чернилами", "чернилах"},
+})
+
+/** @var string[] Существительные, имеющие только единственное число (singularia tantum) */
+var singulariaTantum = str.NewWordSet([]string{
+	// вещества
+	"молоко", "сметана", "творог", "мука", "гречка", "кислород", "водород", "золото", "серебро", "медь", "железо",
+	// собирательные
+	"мебель", "молодёжь", "молодежь", "листва", "детвора", "бельё", "белье", "одежда", "посуда", "обувь", "зелень",
+	"человечество", "студенчество", "крестьянство", "информация",
+	// отвлеченные
+	"любовь", "ненависть", "темнота", "тишина",
+})
+
+/**
+ * Частотные существительные для морфологического разбора. Без словаря по одной словоформе
+ * нельзя отличить начальную форму от основы (окна - окно или окн, задачи - задача или задачь),
+ * поэтому слова из этого списка предпочитаются выведенным по правилам.
+ * @var string[]
+ */
+var commonNouns = str.NewWordSet([]string{
+	// мужской род
+	"стол", "дом", "город", "год", "час", "раз", "мир", "вопрос", "ответ", "закон", "народ", "отдел", "район",
+	"смысл", "театр", "центр", "метр", "литр", "министр", "ветер", "огонь", "отец", "конец", "рынок",
+	"заказ", "товар", "клиент", "сотрудник", "проект", "документ", "файл", "список", "пример", "результат",
+	"процесс", "случай", "край", "музей", "сарай", "герой", "бой", "чай", "месяц", "август", "март", "май",
+	// женский род
+	"задача", "машина", "работа", "страна", "жена", "рука", "нога", "голова", "вода", "земля", "неделя", "минута",
+	"книга", "школа", "улица", "цена", "сумма", "строка", "ошибка", "кнопка", "ложка", "кошка", "комната",
+	"москва", "россия", "история", "компания", "организация", "линия", "семья", "статья", "кухня", "деревня",
+	"ночь", "вещь", "речь", "помощь", "мысль", "жизнь", "часть", "область", "новость", "площадь", "тетрадь",
+	"очередь", "роль", "цель", "связь", "сеть", "осень", "степень", "соль", "боль", "мышь", "дочь", "мать",
+	// средний род
+	"окно", "место", "слово", "дело", "лицо", "письмо", "число", "село", "озеро", "право", "утро", "кресло",
+	"солнце", "сердце", "море", "поле", "здание", "задание", "решение", "значение", "мнение", "время", "имя",
 })
@@ -2,6 +2,8 @@ package declension
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/dshipenok/gomorphos/russian"
 	"github.com/dshipenok/gomorphos/russian/cases"
@@ -16,19 +18,139 @@ import (
  * @return bool
  */
 func IsMutable(w str.Word, animateness bool) bool {
-	w = w.Lower()
-	if w.EndsWith(-1, "у", "и", "е", "о", "ю") || immutableWords.Has(w) {
+	if IsAbbreviation(w) {
+		return IsAbbreviationMutable(w)
+	}
+	// части составного слова склоняются по отдельности
+	if IsCompound(w) {
+		return true
+	}
+
+	lower := w.Lower()
+	if pluraliaTantum.Has(lower) {
+		return true
+	}
+	// адъективные существительные склоняются как прилагательные: мороженое, животное
+	if russian.IsAdjectiveNoun(lower) {
+		return true
+	}
+	if immutableWords.Has(lower) || foreignFemaleNames.Has(lower) || foreignProperNames.Has(lower) {
 		return false
 	}
+
+	// заимствования на -и, -у, -ю, -э: Сочи, Баку, жалюзи, каноэ
+	if lower.EndsWith(1, "и", "у", "ю", "э") {
+		return false
+	}
+
+	// заимствования на гласную + -о, -е: радио, трио, Токио (но здание, собрание)
+	if lower.EndsWith(1, "о", "е") && russian.IsVowel(lower.Chars(-2, -1)) && !lower.EndsWith(2, "ие") {
+		return false
+	}
+
 	return true
 }
 
+/**
+ * Проверка, имеет ли существительное только множественное число: ножницы, сутки, деньги.
+ * @param string $word
+ * @return bool
+ */
+func IsPluraliaTantum(w str.Word) bool {
+	return pluraliaTantum.Has(w.Lower())
+}
+
+/**
+ * Проверка, имеет ли существительное только единственное число: молоко, мебель, молодёжь.
+ * @param string $word
+ * @return bool
+ */
+func IsSingulariaTantum(w str.Word) bool {
+	return singulariaTantum.Has(w.Lower())
+}
+
+/**
+ * Формы существительного, имеющего только множественное число.
+ * @param string $word
+ * @return string[]
+ */
+func getPluraliaTantumCases(w str.Word) map[cases.Case]string {
+	result := map[cases.Case]string{}
+	values := pluraliaTantum.SliceOf(w)
+	for ind, pad := range []cases.Case{cases.Imenit, cases.Rodit, cases.Dat, cases.Vinit, cases.Tvorit, cases.Predloj} {
+		result[pad] = values[ind]
+	}
+	return result
+}
+
+/**
+ * Проверка, входит ли слово в словарь несклоняемых: такси, пальто, меню.
+ * @param string $word
+ * @return bool
+ */
+func IsImmutable(w str.Word) bool {
+	return immutableWords.Has(w.Lower())
+}
+
+/**
+ * Индекс форм слов с нестандартным склонением: словоформа (без ё) - начальная форма.
+ * Строится один раз при первом обращении.
+ */
+var (
+	abnormalLemmas     map[string]string
+	abnormalLemmasOnce sync.Once
+)
+
+/**
+ * Поиск начальной формы для формы слова с нестандартным склонением: пути - путь, времени - время.
+ * @param string $word
+ * @return string
+ * @return bool Признак того, что слово найдено в таблице исключений
+ */
+func GetAbnormalLemma(w str.Word) (string, bool) {
+	w = w.Lower()
+	abnormalLemmasOnce.Do(buildAbnormalLemmas)
+
+	if lemma, has := abnormalLemmas[normalizeYo(w.String())]; has {
+		return lemma, true
+	}
+	return w.String(), false
+}
+
+/**
+ * Заполнение индекса форм слов с нестандартным склонением. При совпадении форм
+ * выигрывает начальная форма, идущая раньше по алфавиту.
+ */
+func buildAbnormalLemmas() {
+	lemmas := make([]string, 0, len(abnormalExceptions.Map))
+	for lemma := range abnormalExceptions.Map {
+		lemmas = append(lemmas, lemma)
+	}
+	sort.Strings(lemmas)
+
+	abnormalLemmas = map[string]string{}
+	for _, lemma := range lemmas {
+		lemmaWord := str.Word(lemma)
+		for _, forms := range []map[cases.Case]string{GetCases(lemmaWord, false), GetPluralCases(lemmaWord, false)} {
+			for _, form := range forms {
+				key := normalizeYo(form)
+				if _, has := abnormalLemmas[key]; !has {
+					abnormalLemmas[key] = lemma
+				}
+			}
+		}
+	}
+}
+
 /**
  * Определение рода существительного.
  * @param string $word
  * @return string
  */
 func DetectGender(w str.Word) gender.Gender {
+	if IsAbbreviation(w) {
+		return GetAbbreviationGender(w)
+	}
 	w = w.Lower()
 	last := w.LastChars(1)
 	// пытаемся угадать род объекта, хотя бы примерно, чтобы правильно склонять
@@ -38,7 +160,7 @@ func DetectGender(w str.Word) gender.Gender {
 
 	if w.EndsWith(1, "а", "я") ||
 		(last == "ь" &&
-			!masculineWithSoft.Has(w) &&
+			!isMasculineWithSoft(w) &&
 			!masculineWithSoftAndRunAwayVowels.Has(w)) {
 		return gender.Female
 	}
@@ -46,6 +168,15 @@ func DetectGender(w str.Word) gender.Gender {
 	return gender.Male
 }
 
+/**
+ * Мужской род на мягкий знак: словарь и названия деятеля на -тель (водитель, пользователь).
+ * @param string $word
+ * @return bool
+ */
+func isMasculineWithSoft(w str.Word) bool {
+	return masculineWithSoft.Has(w) || w.EndsWith(5, "атель", "итель", "ятель")
+}
+
 /**
  * Определение склонения (по школьной программе) существительного.
  * @param string $word
@@ -64,7 +195,7 @@ func GetDeclension(w str.Word, animateness bool) int {
 	} else if russian.IsConsonant(last) || w.EndsWith(1, "о", "е", "ё") ||
 		(last == "ь" && russian.IsConsonant(w.Chars(-2, -1)) &&
 			!russian.IsHissingConsonant(w.Chars(-2, -1)) &&
-			(masculineWithSoft.Has(w) || masculineWithSoftAndRunAwayVowels.Has(w))) {
+			(isMasculineWithSoft(w) || masculineWithSoftAndRunAwayVowels.Has(w))) {
 		return SecondDeclension
 	}
 	return ThirdDeclension
@@ -78,7 +209,22 @@ func GetDeclension(w str.Word, animateness bool) int {
  * @phpstan-return array<string, string>
  */
 func GetCases(w str.Word, animateness bool) map[cases.Case]string {
-	w = w.Lower()
+	if IsAbbreviation(w) {
+		return getAbbreviationCases(w, animateness, getCases)
+	}
+	if !IsMutable(w, animateness) {
+		return cases.NewCasesWord(w)
+	}
+	return cases.ApplyCasePattern(w, getCases(w.Lower(), animateness))
+}
+
+func getCases(w str.Word, animateness bool) map[cases.Case]string {
+	if IsCompound(w) {
+		return getCompoundCases(w, animateness, getCases)
+	}
+	if pluraliaTantum.Has(w) {
+		return getPluraliaTantumCases(w)
+	}
 
 	// Адъективное склонение (Сущ, образованные от прилагательных и причастий) - прохожий, существительное
 	if russian.IsAdjectiveNoun(w) {
@@ -253,7 +399,9 @@ func DeclinateSecondDeclension(w str.Word, animateness bool) map[cases.Case]stri
 	// 	$forms[Cases::TVORIT] = $prefix.'ем';
 	// else
 	// 	$forms[Cases::TVORIT] = $prefix.'ом'; # http://morpher.ru/Russian/Spelling.aspx#sibilant
-	if (russian.IsHissingConsonant(last) && last != "ш") ||
+	if stressedHissingNouns.Has(w) {
+		forms[cases.Tvorit] = prefix + "ом"
+	} else if (russian.IsHissingConsonant(last) && last != "ш") ||
 		(lastWord.OneOf("ь", "е", "ё", "ю", "я") && russian.IsHissingConsonant(w.Chars(-2, -1))) ||
 		(last == "ц" && w.LastChars(2) != "ец") {
 		forms[cases.Tvorit] = prefix + "ем"
@@ -361,6 +509,8 @@ func GetPrefixOfSecondDeclension(w, last str.Word) string {
 	// слова с бегающей гласной в корне
 	if masculineWithSoftAndRunAwayVowels.Has(w) {
 		prefix = w.Chars(0, -3) + w.Chars(-2, -1)
+	} else if masculineWithRunAwayVowels.Has(w) {
+		prefix = masculineWithRunAwayVowels.SliceOf(w)[0]
 	} else if last.OneOf("о", "е", "ё", "ь", "й") {
 		prefix = w.Chars(0, -1)
 	} else if w.LastChars(2) == "ок" && w.Len() > 3 {
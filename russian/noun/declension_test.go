@@ -15,6 +15,70 @@ func Test_GetDeclension(t *testing.T) {
 	assert.EqualValues(t, 3, decls)
 }
 
+func Test_IsMutable(t *testing.T) {
+	tests := []struct {
+		Word    string
+		Mutable bool
+	}{
+		{"Сочи", false},
+		{"Баку", false},
+		{"жалюзи", false},
+		{"радио", false},
+		{"каноэ", false},
+		{"Осло", false},
+		{"Шевченко", false},
+		{"Кармен", false},
+		{"Иваново", true},
+		{"здание", true},
+		{"окно", true},
+		{"поле", true},
+		{"МИД", true},
+		{"МГУ", false},
+		{"диван-кровать", true},
+		{"Окно", true},
+		{"Море", true},
+		{"мороженое", true},
+		{"животное", true},
+		{"Монако", false},
+		{"метро", false},
+		{"фото", false},
+		{"кино", false},
+		{"казино", false},
+		{"пианино", false},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Word, func(t *testing.T) {
+			assert.Equal(t, tst.Mutable, IsMutable(str.Word(tst.Word), false))
+		})
+	}
+}
+
+func Test_GetCases_Immutable(t *testing.T) {
+	for _, w := range []string{"Сочи", "Баку", "жалюзи", "Токио", "метро", "фото", "кино", "казино"} {
+		assert.Equal(t, cases.NewCasesWord(str.Word(w)), GetCases(str.Word(w), false))
+		assert.Equal(t, cases.NewCasesWord(str.Word(w)), GetPluralCases(str.Word(w), false))
+	}
+}
+
+func Test_NumberRestrictions(t *testing.T) {
+	assert.True(t, IsPluraliaTantum(str.Word("ножницы")))
+	assert.False(t, IsPluraliaTantum(str.Word("задачи")))
+	assert.True(t, IsSingulariaTantum(str.Word("молоко")))
+
+	assert.Equal(t, map[cases.Case]string{
+		cases.Imenit:  "ножницы",
+		cases.Rodit:   "ножниц",
+		cases.Dat:     "ножницам",
+		cases.Vinit:   "ножницы",
+		cases.Tvorit:  "ножницами",
+		cases.Predloj: "ножницах",
+	}, GetCases(str.Word("ножницы"), false))
+	assert.Equal(t, GetCases(str.Word("сутки"), false), GetPluralCases(str.Word("сутки"), false))
+	assert.Equal(t, GetCases(str.Word("мебель"), false), GetPluralCases(str.Word("мебель"), false))
+	assert.Equal(t, "суток", Pluralize(str.Word("сутки"), 5, false, cases.Imenit))
+}
+
 func Test_GetCase(t *testing.T) {
 	casedStr := GetCase(str.Word("кухня"), "винительный", false)
 
@@ -26,6 +90,61 @@ func Test_GetCases(t *testing.T) {
 		Word  string
 		Cases map[cases.Case]string
 	}{
+		{
+			Word: "нож",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "нож",
+				cases.Rodit:   "ножа",
+				cases.Dat:     "ножу",
+				cases.Vinit:   "нож",
+				cases.Tvorit:  "ножом",
+				cases.Predloj: "ноже",
+			},
+		},
+		{
+			Word: "конец",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "конец",
+				cases.Rodit:   "конца",
+				cases.Dat:     "концу",
+				cases.Vinit:   "конец",
+				cases.Tvorit:  "концом",
+				cases.Predloj: "конце",
+			},
+		},
+		{
+			Word: "пейзаж",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "пейзаж",
+				cases.Rodit:   "пейзажа",
+				cases.Dat:     "пейзажу",
+				cases.Vinit:   "пейзаж",
+				cases.Tvorit:  "пейзажем",
+				cases.Predloj: "пейзаже",
+			},
+		},
+		{
+			Word: "Москва",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "Москва",
+				cases.Rodit:   "Москвы",
+				cases.Dat:     "Москве",
+				cases.Vinit:   "Москву",
+				cases.Tvorit:  "Москвой",
+				cases.Predloj: "Москве",
+			},
+		},
+		{
+			Word: "ГОРОД",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "ГОРОД",
+				cases.Rodit:   "ГОРОДА",
+				cases.Dat:     "ГОРОДУ",
+				cases.Vinit:   "ГОРОД",
+				cases.Tvorit:  "ГОРОДОМ",
+				cases.Predloj: "ГОРОДЕ",
+			},
+		},
 		{
 			Word: "прохожий",
 			Cases: map[cases.Case]string{
@@ -37,6 +156,28 @@ func Test_GetCases(t *testing.T) {
 				cases.Predloj: "прохожем",
 			},
 		},
+		{
+			Word: "мороженое",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "мороженое",
+				cases.Rodit:   "мороженого",
+				cases.Dat:     "мороженому",
+				cases.Vinit:   "мороженое",
+				cases.Tvorit:  "мороженым",
+				cases.Predloj: "мороженом",
+			},
+		},
+		{
+			Word: "Окно",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "Окно",
+				cases.Rodit:   "Окна",
+				cases.Dat:     "Окну",
+				cases.Vinit:   "Окно",
+				cases.Tvorit:  "Окном",
+				cases.Predloj: "Окне",
+			},
+		},
 		{
 			Word: "лошадь",
 			Cases: map[cases.Case]string{
@@ -126,3 +267,140 @@ func Test_GetCases(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetPluralCases(t *testing.T) {
+	tests := []struct {
+		Word        string
+		Animateness bool
+		Cases       map[cases.Case]string
+	}{
+		{
+			Word: "Кнопка",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "Кнопки",
+				cases.Rodit:   "Кнопок",
+				cases.Dat:     "Кнопкам",
+				cases.Vinit:   "Кнопки",
+				cases.Tvorit:  "Кнопками",
+				cases.Predloj: "Кнопках",
+			},
+		},
+		{
+			Word: "коридор",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "коридоры",
+				cases.Rodit:   "коридоров",
+				cases.Dat:     "коридорам",
+				cases.Vinit:   "коридоры",
+				cases.Tvorit:  "коридорами",
+				cases.Predloj: "коридорах",
+			},
+		},
+		{
+			Word: "кухня",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "кухни",
+				cases.Rodit:   "кухонь",
+				cases.Dat:     "кухням",
+				cases.Vinit:   "кухни",
+				cases.Tvorit:  "кухнями",
+				cases.Predloj: "кухнях",
+			},
+		},
+		{
+			Word:        "пользователь",
+			Animateness: true,
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "пользователи",
+				cases.Rodit:   "пользователей",
+				cases.Dat:     "пользователям",
+				cases.Vinit:   "пользователей",
+				cases.Tvorit:  "пользователями",
+				cases.Predloj: "пользователях",
+			},
+		},
+		{
+			Word: "кнопка",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "кнопки",
+				cases.Rodit:   "кнопок",
+				cases.Dat:     "кнопкам",
+				cases.Vinit:   "кнопки",
+				cases.Tvorit:  "кнопками",
+				cases.Predloj: "кнопках",
+			},
+		},
+		{
+			Word: "здание",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "здания",
+				cases.Rodit:   "зданий",
+				cases.Dat:     "зданиям",
+				cases.Vinit:   "здания",
+				cases.Tvorit:  "зданиями",
+				cases.Predloj: "зданиях",
+			},
+		},
+		{
+			Word: "имя",
+			Cases: map[cases.Case]string{
+				cases.Imenit:  "имена",
+				cases.Rodit:   "имён",
+				cases.Dat:     "именам",
+				cases.Vinit:   "имена",
+				cases.Tvorit:  "именами",
+				cases.Predloj: "именах",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Word, func(t *testing.T) {
+			w := str.Word(tst.Word)
+
+			cases := GetPluralCases(w, tst.Animateness)
+
+			assert.Equal(t, tst.Cases, cases)
+		})
+	}
+}
+
+func Test_GetPluralCases_ShortWords(t *testing.T) {
+	for _, w := range []string{"ня", "ля", "ья", "ия", "ка", "ма", "о", "я"} {
+		t.Run(w, func(t *testing.T) {
+			assert.NotPanics(t, func() {
+				GetCases(str.Word(w), false)
+				GetPluralCases(str.Word(w), false)
+			})
+		})
+	}
+	assert.Equal(t, "нь", GetPluralCases(str.Word("ня"), false)[cases.Rodit])
+}
+
+func Test_GetAbnormalLemma(t *testing.T) {
+	tests := []struct {
+		Word  string
+		Lemma string
+		Found bool
+	}{
+		{"пути", "путь", true},
+		{"времён", "время", true},
+		{"Времени", "время", true},
+		{"стола", "стола", false},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Word, func(t *testing.T) {
+			lemma, found := GetAbnormalLemma(str.Word(tst.Word))
+			assert.Equal(t, tst.Lemma, lemma)
+			assert.Equal(t, tst.Found, found)
+		})
+	}
+}
+
+func Test_GetPluralCases_AbnormalVinit(t *testing.T) {
+	assert.Equal(t, "людей", GetPluralCases(str.Word("человек"), true)[cases.Vinit])
+	assert.Equal(t, "люди", GetPluralCases(str.Word("человек"), false)[cases.Vinit])
+	assert.Equal(t, "стулья", GetPluralCases(str.Word("стул"), false)[cases.Vinit])
+	assert.Equal(t, "стульев", GetPluralCases(str.Word("стул"), true)[cases.Vinit])
+}
@@ -0,0 +1,368 @@
+package declension
+
+import (
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение слова во всех 6 падежах во множественном числе.
+ * @param string $word
+ * @param bool $animateness Признак одушевлённости
+ * @return string[]
+ * @phpstan-return array<string, string>
+ */
+func GetPluralCases(w str.Word, animateness bool) map[cases.Case]string {
+	if IsAbbreviation(w) {
+		return getAbbreviationCases(w, animateness, getPluralCases)
+	}
+	if !IsMutable(w, animateness) {
+		return cases.NewCasesWord(w)
+	}
+	return cases.ApplyCasePattern(w, getPluralCases(w.Lower(), animateness))
+}
+
+func getPluralCases(w str.Word, animateness bool) map[cases.Case]string {
+	if IsCompound(w) {
+		return getCompoundCases(w, animateness, getPluralCases)
+	}
+	if pluraliaTantum.Has(w) {
+		return getPluraliaTantumCases(w)
+	}
+	if singulariaTantum.Has(w) {
+		return getCases(w, animateness)
+	}
+
+	if immutableWords.Has(w) {
+		return cases.NewCasesWord(w)
+	}
+
+	if pluralAbnormalExceptions.Has(w) {
+		result := map[cases.Case]string{}
+		values := pluralAbnormalExceptions.SliceOf(w)
+		for ind, pad := range []cases.Case{cases.Imenit, cases.Rodit, cases.Dat, cases.Vinit, cases.Tvorit, cases.Predloj} {
+			result[pad] = values[ind]
+		}
+		// винительный падеж зависит от одушевленности, а не от таблицы: вижу людей, вижу стулья
+		result[cases.Vinit] = russian.GetVinitCaseByAnimateness(result, animateness)
+		return result
+	}
+
+	// Адъективное склонение (Сущ, образованные от прилагательных и причастий) - прохожий, существительное
+	if russian.IsAdjectiveNoun(w) {
+		return DeclinatePluralAdjective(w, animateness)
+	}
+
+	// Субстантивное склонение (существительные)
+	return DeclinatePluralSubstantive(w, animateness)
+}
+
+/**
+ * Получение одной формы слова (падежа) во множественном числе.
+ * @param string $word Слово
+ * @param string $case Падеж
+ * @param bool $animateness Признак одушевленности
+ * @return string
+ */
+func GetPluralCase(w str.Word, wCase string, animateness bool) string {
+	cCase := cases.CanonizeCase(wCase)
+	forms := GetPluralCases(w, animateness)
+	return forms[cCase]
+}
+
+/**
+ * Склонение во множественном числе существительных, образованных от прилагательных и причастий.
+ * @param string $word
+ * @param bool $animateness
+ * @return string[]
+ */
+func DeclinatePluralAdjective(w str.Word, animateness bool) map[cases.Case]string {
+	prefix := w.Chars(0, -2)
+	last := str.Word(prefix).LastChars(1)
+	vowel := "ы"
+	if w.EndsWith(2, "ий", "ее", "яя") || russian.IsHissingConsonant(last) || russian.IsVelarConsonant(last) {
+		vowel = "и"
+	}
+
+	forms := map[cases.Case]string{
+		cases.Imenit:  prefix + vowel + "е",
+		cases.Rodit:   prefix + vowel + "х",
+		cases.Dat:     prefix + vowel + "м",
+		cases.Tvorit:  prefix + vowel + "ми",
+		cases.Predloj: prefix + vowel + "х",
+	}
+	forms[cases.Vinit] = russian.GetVinitCaseByAnimateness(forms, animateness)
+	return forms
+}
+
+/**
+ * Получение всех форм множественного числа для существительных субстантивного склонения.
+ * @param string $word
+ * @param bool $animateness
+ * @return string[]
+ */
+func DeclinatePluralSubstantive(w str.Word, animateness bool) map[cases.Case]string {
+	w = w.Lower()
+	lastWord := w.LastCharsWord(1)
+	last := lastWord.String()
+	prelast := w.Chars(-2, -1)
+
+	// разносклоняемые на -мя: имя - имена - имён
+	if w.EndsWith(2, "мя") {
+		prefix := w.Chars(0, -1) + "ен"
+		forms := map[cases.Case]string{
+			cases.Imenit:  prefix + "а",
+			cases.Rodit:   w.Chars(0, -1) + "ён",
+			cases.Dat:     prefix + "ам",
+			cases.Tvorit:  prefix + "ами",
+			cases.Predloj: prefix + "ах",
+		}
+		if genitive, has := genitiveExceptions[w.String()]; has {
+			forms[cases.Rodit] = genitive
+		}
+		forms[cases.Vinit] = forms[cases.Imenit]
+		return forms
+	}
+
+	// детёныши: котёнок - котята, медвежонок - медвежата
+	if w.EndsWith(4, "ёнок", "енок", "онок") {
+		stem := w.Chars(0, -4)
+		prefix := stem + "ят"
+		if russian.IsHissingConsonant(str.Word(stem).LastChars(1)) {
+			prefix = stem + "ат"
+		}
+		forms := map[cases.Case]string{
+			cases.Imenit:  prefix + "а",
+			cases.Rodit:   prefix,
+			cases.Dat:     prefix + "ам",
+			cases.Tvorit:  prefix + "ами",
+			cases.Predloj: prefix + "ах",
+		}
+		forms[cases.Vinit] = russian.GetVinitCaseByAnimateness(forms, animateness)
+		return forms
+	}
+
+	var prefix string
+	if GetDeclension(w, animateness) == SecondDeclension {
+		prefix = GetPrefixOfSecondDeclension(w, lastWord)
+	} else {
+		prefix = w.Chars(0, -1)
+	}
+
+	softLast := last == "й" ||
+		(lastWord.OneOf("ь", "я", "е", "ё", "ю") &&
+			!russian.IsHissingConsonant(prelast) && prelast != "ц")
+
+	forms := map[cases.Case]string{}
+
+	// IMENIT
+	switch {
+	case last == "о":
+		forms[cases.Imenit] = prefix + "а"
+	case lastWord.OneOf("е", "ё"):
+		forms[cases.Imenit] = russian.ChooseVowelAfterConsonant(last, softLast, prefix+"я", prefix+"а")
+	case softLast:
+		forms[cases.Imenit] = prefix + "и"
+	case last == "а":
+		if russian.IsHissingConsonant(prelast) || russian.IsVelarConsonant(prelast) {
+			forms[cases.Imenit] = prefix + "и"
+		} else {
+			forms[cases.Imenit] = prefix + "ы"
+		}
+	case russian.IsHissingConsonant(last) || russian.IsVelarConsonant(last) || last == "ь":
+		forms[cases.Imenit] = prefix + "и"
+	default:
+		forms[cases.Imenit] = prefix + "ы"
+	}
+
+	// RODIT
+	forms[cases.Rodit] = getPluralGenitive(w, prefix, softLast)
+
+	// DAT, TVORIT, PREDLOJ
+	forms[cases.Dat] = russian.ChooseVowelAfterConsonant(last, softLast, prefix+"ям", prefix+"ам")
+	forms[cases.Tvorit] = russian.ChooseVowelAfterConsonant(last, softLast, prefix+"ями", prefix+"ами")
+	forms[cases.Predloj] = russian.ChooseVowelAfterConsonant(last, softLast, prefix+"ях", prefix+"ах")
+
+	// VINIT
+	forms[cases.Vinit] = russian.GetVinitCaseByAnimateness(forms, animateness)
+
+	return forms
+}
+
+/**
+ * Родительный падеж множественного числа.
+ * @param string $word
+ * @param string $prefix
+ * @param bool $softLast
+ * @return string
+ */
+func getPluralGenitive(w str.Word, prefix string, softLast bool) string {
+	if genitive, has := genitiveExceptions[w.String()]; has {
+		return genitive
+	}
+
+	last := w.LastChars(1)
+	prelast := w.Chars(-2, -1)
+
+	switch {
+	case last == "о" || last == "е" || last == "ё":
+		if neuterExceptions.Has(w) {
+			return prefix + "ей"
+		}
+		if prelast == "и" {
+			return prefix + "й"
+		}
+		if prelast == "ь" {
+			return w.Chars(0, -2) + "ей"
+		}
+		return insertRunawayVowel(str.Word(prefix))
+
+	case last == "а":
+		// чашка, вилка, ложка, копейка, кнопка
+		if w.Len() > 2 && prelast == "к" && !russian.IsVowel(w.Chars(-3, -2)) {
+			before := w.Chars(-3, -2)
+			switch {
+			case before == "й" || before == "ь":
+				return w.Chars(0, -3) + "ек"
+			case russian.IsHissingConsonant(before):
+				return w.Chars(0, -2) + "ек"
+			default:
+				return w.Chars(0, -2) + "ок"
+			}
+		}
+		return prefix
+
+	case last == "я":
+		switch {
+		case prelast == "ь":
+			// статья, семья
+			return w.Chars(0, -2) + "ей"
+		case russian.IsVowel(prelast):
+			// молния, шея
+			return prefix + "й"
+		case w.Len() > 2 && !russian.IsVowel(w.Chars(-3, -2)) && prelast == "н":
+			// башня, спальня
+			return trimSoftSign(w.Chars(0, -2)) + "ен"
+		case w.Len() > 3 && !russian.IsVowel(w.Chars(-3, -2)):
+			// капля, земля
+			return w.Chars(0, -2) + "е" + prelast + "ь"
+		default:
+			// неделя, пуля
+			return prefix + "ь"
+		}
+
+	case last == "ь" || russian.IsHissingConsonant(last):
+		return prefix + "ей"
+
+	case last == "й" || w.LastChars(2) == "яц":
+		return prefix + "ев"
+	}
+
+	if softLast {
+		return prefix + "ей"
+	}
+	return prefix + "ов"
+}
+
+/**
+ * Вставка беглой гласной между двумя последними согласными: окно - окон, число - чисел.
+ * @param string $prefix
+ * @return string
+ */
+func insertRunawayVowel(prefix str.Word) string {
+	if prefix.Len() < 3 {
+		return prefix.String()
+	}
+
+	last := prefix.LastChars(1)
+	prelast := prefix.Chars(-2, -1)
+	if !russian.IsConsonant(last) || !russian.IsConsonant(prelast) ||
+		prefix.EndsWith(2, "ст", "зд", "ск", "тв") {
+		return prefix.String()
+	}
+
+	vowel := "е"
+	if russian.IsVelarConsonant(prelast) {
+		vowel = "о"
+	}
+	return prefix.Chars(0, -1) + vowel + last
+}
+
+/**
+ * Отбрасывание конечного мягкого знака.
+ * @param string $string
+ * @return string
+ */
+func trimSoftSign(s string) string {
+	w := str.Word(s)
+	if w.LastChars(1) == "ь" {
+		return w.Chars(0, -1)
+	}
+	return s
+}
+
+/**
+ * Получение формы существительного, стоящего после числа.
+ * @param string $word
+ * @param int $count
+ * @param bool $animateness
+ * @param string $case
+ * @return string
+ */
+func Pluralize(w str.Word, count int64, animateness bool, cCase cases.Case) string {
+	form := GetNumeralForm(count)
+	if form == One {
+		return GetCases(w, animateness)[cCase]
+	}
+
+	pluralForms := GetPluralCases(w, animateness)
+	switch cCase {
+	case cases.Imenit, cases.Vinit:
+		// вижу двух пользователей, но вижу двадцать два пользователя
+		if cCase == cases.Vinit && animateness && IsSimpleTwoFour(count) {
+			return pluralForms[cases.Vinit]
+		}
+		if form == TwoFour {
+			return GetCases(w, animateness)[cases.Rodit]
+		}
+		return pluralForms[cases.Rodit]
+	default:
+		return pluralForms[cCase]
+	}
+}
+
+/**
+ * Определение формы числительного для согласования с существительным.
+ * @param int $count
+ * @return int
+ */
+func GetNumeralForm(count int64) int {
+	if count < 0 {
+		count = -count
+	}
+	if count > 100 {
+		count %= 100
+	}
+	ending := count % 10
+
+	if (count > 20 && ending == 1) || count == 1 {
+		return One
+	} else if (count > 20 && ending >= 2 && ending <= 4) || (count >= 2 && count <= 4) {
+		return TwoFour
+	}
+	return FiveOther
+}
+
+/**
+ * Число 2, 3 или 4 без других разрядов: только после них винительный падеж
+ * одушевленных существительных совпадает с родительным (вижу двух котов).
+ * @param int $count
+ * @return bool
+ */
+func IsSimpleTwoFour(count int64) bool {
+	if count < 0 {
+		count = -count
+	}
+	return count >= 2 && count <= 4
+}
@@ -0,0 +1,8 @@
+package number
+
+type Number int
+
+const (
+	Singular Number = 0 //"singular"
+	Plural   Number = 1 //"plural"
+)
@@ -0,0 +1,197 @@
+package numeral
+
+import (
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+var caseOrder = []cases.Case{cases.Imenit, cases.Rodit, cases.Dat, cases.Vinit, cases.Tvorit, cases.Predloj}
+
+/**
+ * Получение количественного числительного во всех 6 падежах.
+ * @param int $number
+ * @param string $gender Род существительного, с которым согласуется числительное
+ * @param bool $animateness Признак одушевленности существительного
+ * @return string[]
+ */
+func GetCardinalCases(n int64, gendr gender.Gender, animateness bool) cases.Cases {
+	if n == 0 {
+		return getWordCases("ноль")
+	}
+
+	// модуль числа считается без знака: -n переполняется для math.MinInt64
+	var prefix string
+	abs := uint64(n)
+	if n < 0 {
+		prefix = "минус "
+		abs = uint64(-(n + 1)) + 1
+	}
+
+	parts := []cases.Cases{}
+	rest := abs
+	for _, exp := range exponents {
+		count := int64(rest / uint64(exp.Value))
+		if count == 0 {
+			continue
+		}
+		rest %= uint64(exp.Value)
+
+		expGender := gender.Male
+		if exp.Word == "тысяча" {
+			expGender = gender.Female
+		}
+		parts = append(parts, getHundredsCases(count, expGender)...)
+
+		expForms := cases.NewCases()
+		for _, cCase := range caseOrder {
+			expForms[cCase] = declension.Pluralize(str.Word(exp.Word), count, false, cCase)
+		}
+		parts = append(parts, expForms)
+	}
+	parts = append(parts, getHundredsCases(int64(rest), gendr)...)
+
+	result := joinCases(parts)
+	for _, cCase := range caseOrder {
+		result[cCase] = prefix + result[cCase]
+	}
+
+	// вижу одного кота, двух котов, но двадцать два кота
+	if animateness {
+		if declension.IsSimpleTwoFour(n) {
+			result[cases.Vinit] = result[cases.Rodit]
+		} else if abs%10 == 1 && abs%100 != 11 && gendr != gender.Female {
+			last := parts[len(parts)-1]
+			result[cases.Vinit] = strings.TrimSuffix(result[cases.Vinit], last[cases.Vinit]) + last[cases.Rodit]
+		}
+	}
+
+	return result
+}
+
+/**
+ * Получение одной формы количественного числительного (падежа).
+ * @param int $number
+ * @param string $case Падеж
+ * @param string $gender Род
+ * @param bool $animateness Признак одушевленности
+ * @return string
+ */
+func GetCardinalCase(n int64, wCase string, gendr gender.Gender, animateness bool) string {
+	cCase := cases.CanonizeCase(wCase)
+	return GetCardinalCases(n, gendr, animateness)[cCase]
+}
+
+/**
+ * Формы слов числа от 1 до 999.
+ * @param int $number
+ * @param string $gender
+ * @return string[][]
+ */
+func getHundredsCases(n int64, gendr gender.Gender) []cases.Cases {
+	parts := []cases.Cases{}
+
+	if h := n / 100; h > 0 {
+		parts = append(parts, getHundredCases(h))
+	}
+
+	n %= 100
+	if n >= 20 {
+		parts = append(parts, getWordCases(tens[n/10]))
+		n %= 10
+	}
+
+	if n > 0 {
+		parts = append(parts, getWordCases(getUnitWord(n, gendr)))
+	}
+	return parts
+}
+
+/**
+ * Сотни: двести - двухсот - двумстам, пятьсот - пятисот - пятистам.
+ * @param int $hundreds
+ * @return string[]
+ */
+func getHundredCases(h int64) cases.Cases {
+	if h == 1 {
+		return getWordCases("сто")
+	}
+
+	multiplier := getWordCases(getUnitWord(h, gender.Female))
+	return cases.Cases{
+		cases.Imenit:  hundreds[h],
+		cases.Rodit:   multiplier[cases.Rodit] + "сот",
+		cases.Dat:     multiplier[cases.Dat] + "стам",
+		cases.Vinit:   hundreds[h],
+		cases.Tvorit:  multiplier[cases.Tvorit] + "стами",
+		cases.Predloj: multiplier[cases.Predloj] + "стах",
+	}
+}
+
+/**
+ * @param int $unit
+ * @param string $gender
+ * @return string
+ */
+func getUnitWord(n int64, gendr gender.Gender) string {
+	switch {
+	case n == 1 && gendr == gender.Female:
+		return "одна"
+	case n == 1 && gendr == gender.Neuter:
+		return "одно"
+	case n == 2 && gendr == gender.Female:
+		return "две"
+	}
+	return units[n]
+}
+
+/**
+ * Склонение простого числительного: таблица исключений либо третье склонение (пять - пяти - пятью).
+ * Составные десятки склоняются обеими частями: пятьдесят - пятидесяти - пятьюдесятью.
+ * @param string $numeral
+ * @return string[]
+ */
+func getWordCases(word string) cases.Cases {
+	w := str.Word(word)
+	if precalculated.Has(w) {
+		result := cases.NewCases()
+		for ind, cCase := range caseOrder {
+			result[cCase] = precalculated.SliceOf(w)[ind]
+		}
+		return result
+	}
+
+	if w.EndsWith(5, "десят") {
+		first := getWordCases(w.Chars(0, -5))
+		return cases.Cases{
+			cases.Imenit:  word,
+			cases.Rodit:   first[cases.Rodit] + "десяти",
+			cases.Dat:     first[cases.Dat] + "десяти",
+			cases.Vinit:   word,
+			cases.Tvorit:  first[cases.Tvorit] + "десятью",
+			cases.Predloj: first[cases.Predloj] + "десяти",
+		}
+	}
+
+	return declension.DeclinateThirdDeclension(w)
+}
+
+/**
+ * Объединение форм нескольких слов в формы фразы.
+ * @param string[][] $parts
+ * @return string[]
+ */
+func joinCases(parts []cases.Cases) cases.Cases {
+	result := cases.NewCases()
+	for _, cCase := range caseOrder {
+		words := make([]string, 0, len(parts))
+		for _, part := range parts {
+			words = append(words, part[cCase])
+		}
+		result[cCase] = strings.Join(words, " ")
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+package numeral
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetCardinalCases(t *testing.T) {
+	tests := []struct {
+		Number      int64
+		Gender      gender.Gender
+		Animateness bool
+		Cases       cases.Cases
+	}{
+		{
+			Number: 22,
+			Gender: gender.Female,
+			Cases: cases.Cases{
+				cases.Imenit:  "двадцать две",
+				cases.Rodit:   "двадцати двух",
+				cases.Dat:     "двадцати двум",
+				cases.Vinit:   "двадцать две",
+				cases.Tvorit:  "двадцатью двумя",
+				cases.Predloj: "двадцати двух",
+			},
+		},
+		{
+			Number:      2,
+			Gender:      gender.Male,
+			Animateness: true,
+			Cases: cases.Cases{
+				cases.Imenit:  "два",
+				cases.Rodit:   "двух",
+				cases.Dat:     "двум",
+				cases.Vinit:   "двух",
+				cases.Tvorit:  "двумя",
+				cases.Predloj: "двух",
+			},
+		},
+		{
+			Number: 384,
+			Gender: gender.Male,
+			Cases: cases.Cases{
+				cases.Imenit:  "триста восемьдесят четыре",
+				cases.Rodit:   "трёхсот восьмидесяти четырёх",
+				cases.Dat:     "трёмстам восьмидесяти четырём",
+				cases.Vinit:   "триста восемьдесят четыре",
+				cases.Tvorit:  "тремястами восемьюдесятью четырьмя",
+				cases.Predloj: "трёхстах восьмидесяти четырёх",
+			},
+		},
+		{
+			Number: 2500001,
+			Gender: gender.Neuter,
+			Cases: cases.Cases{
+				cases.Imenit:  "два миллиона пятьсот тысяч одно",
+				cases.Rodit:   "двух миллионов пятисот тысяч одного",
+				cases.Dat:     "двум миллионам пятистам тысячам одному",
+				cases.Vinit:   "два миллиона пятьсот тысяч одно",
+				cases.Tvorit:  "двумя миллионами пятьюстами тысячами одним",
+				cases.Predloj: "двух миллионах пятистах тысячах одном",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(GetCardinalCase(tst.Number, "и", tst.Gender, false), func(t *testing.T) {
+			cases := GetCardinalCases(tst.Number, tst.Gender, tst.Animateness)
+
+			assert.Equal(t, tst.Cases, cases)
+		})
+	}
+}
+
+func Test_GetCardinalCases_MinInt64(t *testing.T) {
+	assert.Equal(t,
+		"минус девять квинтиллионов двести двадцать три квадриллиона триста семьдесят два триллиона "+
+			"тридцать шесть миллиардов восемьсот пятьдесят четыре миллиона семьсот семьдесят пять тысяч восемьсот восемь",
+		GetCardinalCases(math.MinInt64, gender.Male, false)[cases.Imenit])
+}
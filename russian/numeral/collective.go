@@ -0,0 +1,115 @@
+package numeral
+
+import (
+	"errors"
+	"math"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение собирательного числительного (двое, трое, ..., десятеро) во всех 6 падежах.
+ * @param int $number Число от 2 до 10
+ * @param bool $animateness Признак одушевленности существительного
+ * @return string[]
+ * @throws \Exception
+ */
+func GetCollectiveCases(n int64, animateness bool) (cases.Cases, error) {
+	if n < 2 || n >= int64(len(collectiveUnits)) {
+		return GetCardinalCases(n, gender.Male, animateness), errors.New("collective numeral exists only for numbers from 2 to 10")
+	}
+	return getCollectiveWordCases(collectiveUnits[n], animateness), nil
+}
+
+/**
+ * Получение числительного "оба" ("обе" для женского рода) во всех 6 падежах.
+ * @param string $gender Род существительного
+ * @param bool $animateness Признак одушевленности существительного
+ * @return string[]
+ */
+func GetBothCases(gendr gender.Gender, animateness bool) cases.Cases {
+	if gendr == gender.Female {
+		return getCollectiveWordCases("обе", animateness)
+	}
+	return getCollectiveWordCases("оба", animateness)
+}
+
+/**
+ * Проверка, требуется ли собирательное числительное вместо количественного:
+ * при существительных, имеющих только множественное число (двое суток),
+ * и при личных местоимениях (нас трое, их пятеро).
+ * @param string $word
+ * @return bool
+ */
+func IsCollectiveRequired(w str.Word) bool {
+	return declension.IsPluraliaTantum(w) || w.Lower().OneOf("мы", "вы", "они")
+}
+
+/**
+ * Проверка, можно ли употребить собирательное числительное: кроме обязательных случаев,
+ * оно сочетается с названиями лиц мужского пола (трое гостей, двое друзей) и детей (двое детей, трое сирот).
+ * @param string $word
+ * @param bool $animateness Признак одушевленности
+ * @return bool
+ */
+func IsCollectiveAllowed(w str.Word, animateness bool) bool {
+	if IsCollectiveRequired(w) || collectivePersonNouns.Has(w.Lower()) {
+		return true
+	}
+	return animateness && declension.DetectGender(w) == gender.Male
+}
+
+/**
+ * Получение числительного для существительного, имеющего только множественное число, во всех 6 падежах:
+ * одни сутки, двое ножниц, трое суток, двадцать одни сутки, пять суток.
+ * @param int $number
+ * @param bool $animateness Признак одушевленности существительного
+ * @return string[]
+ */
+func GetPluraliaTantumCases(n int64, animateness bool) cases.Cases {
+	// -math.MinInt64 переполняется; число оканчивается на 8 и склоняется как количественное
+	if n == math.MinInt64 {
+		return GetCardinalCases(n, gender.Male, animateness)
+	}
+	if n < 0 {
+		result := GetPluraliaTantumCases(-n, animateness)
+		for _, cCase := range caseOrder {
+			result[cCase] = "минус " + result[cCase]
+		}
+		return result
+	}
+
+	switch {
+	case declension.IsSimpleTwoFour(n):
+		return getCollectiveWordCases(collectiveUnits[n], animateness)
+
+	case n%10 == 1 && n%100 != 11:
+		result := getCollectiveWordCases(collectiveUnits[1], animateness)
+		if n == 1 {
+			return result
+		}
+		return joinCases([]cases.Cases{GetCardinalCases(n-1, gender.Male, false), result})
+	}
+
+	return GetCardinalCases(n, gender.Male, animateness)
+}
+
+/**
+ * @param string $numeral
+ * @param bool $animateness
+ * @return string[]
+ */
+func getCollectiveWordCases(word string, animateness bool) cases.Cases {
+	w := str.Word(word)
+	result := cases.NewCases()
+	for ind, cCase := range caseOrder {
+		result[cCase] = collective.SliceOf(w)[ind]
+	}
+	if animateness {
+		result[cases.Vinit] = result[cases.Rodit]
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package numeral
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetPluraliaTantumCases(t *testing.T) {
+	tests := []struct {
+		Number int64
+		Cases  cases.Cases
+	}{
+		{
+			Number: 1,
+			Cases: cases.Cases{
+				cases.Imenit: "одни", cases.Rodit: "одних", cases.Dat: "одним",
+				cases.Vinit: "одни", cases.Tvorit: "одними", cases.Predloj: "одних",
+			},
+		},
+		{
+			Number: 3,
+			Cases: cases.Cases{
+				cases.Imenit: "трое", cases.Rodit: "троих", cases.Dat: "троим",
+				cases.Vinit: "трое", cases.Tvorit: "троими", cases.Predloj: "троих",
+			},
+		},
+		{
+			Number: 5,
+			Cases: cases.Cases{
+				cases.Imenit: "пять", cases.Rodit: "пяти", cases.Dat: "пяти",
+				cases.Vinit: "пять", cases.Tvorit: "пятью", cases.Predloj: "пяти",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Cases[cases.Imenit], func(t *testing.T) {
+			assert.Equal(t, tst.Cases, GetPluraliaTantumCases(tst.Number, false))
+		})
+	}
+
+	assert.Equal(t, GetCardinalCases(math.MinInt64, gender.Male, false), GetPluraliaTantumCases(math.MinInt64, false))
+}
+
+func Test_GetCollectiveCases(t *testing.T) {
+	forms, err := GetCollectiveCases(3, true)
+	assert.NoError(t, err)
+	assert.Equal(t, cases.Cases{
+		cases.Imenit: "трое", cases.Rodit: "троих", cases.Dat: "троим",
+		cases.Vinit: "троих", cases.Tvorit: "троими", cases.Predloj: "троих",
+	}, forms)
+
+	forms, err = GetCollectiveCases(7, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "семерыми", forms[cases.Tvorit])
+
+	_, err = GetCollectiveCases(11, false)
+	assert.Error(t, err)
+}
+
+func Test_GetBothCases(t *testing.T) {
+	assert.Equal(t, cases.Cases{
+		cases.Imenit: "оба", cases.Rodit: "обоих", cases.Dat: "обоим",
+		cases.Vinit: "обоих", cases.Tvorit: "обоими", cases.Predloj: "обоих",
+	}, GetBothCases(gender.Male, true))
+	assert.Equal(t, "обеими", GetBothCases(gender.Female, false)[cases.Tvorit])
+}
+
+func Test_IsCollective(t *testing.T) {
+	assert.True(t, IsCollectiveRequired(str.Word("сутки")))
+	assert.True(t, IsCollectiveRequired(str.Word("Мы")))
+	assert.False(t, IsCollectiveRequired(str.Word("гость")))
+	assert.True(t, IsCollectiveAllowed(str.Word("гость"), true))
+	assert.True(t, IsCollectiveAllowed(str.Word("дети"), true))
+	assert.False(t, IsCollectiveAllowed(str.Word("стол"), false))
+	assert.False(t, IsCollectiveAllowed(str.Word("девушка"), true))
+}
@@ -0,0 +1,126 @@
+package numeral
+
+import (
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Формы числительных, которые не склоняются по общим правилам.
+ * Порядок: именительный, родительный, дательный, винительный, творительный, предложный.
+ */
+var precalculated = str.NewWordMap(map[string][]string{
+	"ноль":      {"ноль", "ноля", "нолю", "ноль", "нолём", "ноле"},
+	"один":      {"один", "одного", "одному", "один", "одним", "одном"},
+	"одна":      {"одна", "одной", "одной", "одну", "одной", "одной"},
+	"одно":      {"одно", "одного", "одному", "одно", "одним", "одном"},
+	"два":       {"два", "двух", "двум", "два", "двумя", "двух"},
+	"две":       {"две", "двух", "двум", "две", "двумя", "двух"},
+	"три":       {"три", "трёх", "трём", "три", "тремя", "трёх"},
+	"четыре":    {"четыре", "четырёх", "четырём", "четыре", "четырьмя", "четырёх"},
+	"восемь":    {"восемь", "восьми", "восьми", "восемь", "восемью", "восьми"},
+	"сорок":     {"сорок", "сорока", "сорока", "сорок", "сорока", "сорока"},
+	"девяносто": {"девяносто", "девяноста", "девяноста", "девяносто", "девяноста", "девяноста"},
+	"сто":       {"сто", "ста", "ста", "сто", "ста", "ста"},
+})
+
+var units = []string{
+	"", "один", "два", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять",
+	"десять", "одиннадцать", "двенадцать", "тринадцать", "четырнадцать",
+	"пятнадцать", "шестнадцать", "семнадцать", "восемнадцать", "девятнадцать",
+}
+
+var tens = []string{
+	"", "", "двадцать", "тридцать", "сорок", "пятьдесят", "шестьдесят", "семьдесят", "восемьдесят", "девяносто",
+}
+
+var hundreds = []string{
+	"", "сто", "двести", "триста", "четыреста", "пятьсот", "шестьсот", "семьсот", "восемьсот", "девятьсот",
+}
+
+/**
+ * Разряды: тысяча - женского рода, остальные - мужского.
+ */
+var exponents = []struct {
+	Value int64
+	Word  string
+}{
+	{1000000000000000000, "квинтиллион"},
+	{1000000000000000, "квадриллион"},
+	{1000000000000, "триллион"},
+	{1000000000, "миллиард"},
+	{1000000, "миллион"},
+	{1000, "тысяча"},
+}
+
+/**
+ * Собирательные числительные, "оба/обе" и форма "одни" для существительных, имеющих только множественное число.
+ * Порядок: именительный, родительный, дательный, винительный, творительный, предложный.
+ */
+var collective = str.NewWordMap(map[string][]string{
+	"одни":     {"одни", "одних", "одним", "одни", "одними", "одних"},
+	"двое":     {"двое", "двоих", "двоим", "двое", "двоими", "двоих"},
+	"трое":     {"трое", "троих", "троим", "трое", "троими", "троих"},
+	"четверо":  {"четверо", "четверых", "четверым", "четверо", "четверыми", "четверых"},
+	"пятеро":   {"пятеро", "пятерых", "пятерым", "пятеро", "пятерыми", "пятерых"},
+	"шестеро":  {"шестеро", "шестерых", "шестерым", "шестеро", "шестерыми", "шестерых"},
+	"семеро":   {"семеро", "семерых", "семерым", "семеро", "семерыми", "семерых"},
+	"восьмеро": {"восьмеро", "восьмерых", "восьмерым", "восьмеро", "восьмерыми", "восьмерых"},
+	"девятеро": {"девятеро", "девятерых", "девятерым", "девятеро", "девятерыми", "девятерых"},
+	"десятеро": {"десятеро", "десятерых", "десятерым", "десятеро", "десятерыми", "десятерых"},
+	"оба":      {"оба", "обоих", "обоим", "оба", "обоими", "обоих"},
+	"обе":      {"обе", "обеих", "обеим", "обе", "обеими", "обеих"},
+})
+
+var collectiveUnits = []string{
+	"", "одни", "двое", "трое", "четверо", "пятеро", "шестеро", "семеро", "восьмеро", "девятеро", "десятеро",
+}
+
+/** @var string[] Существительные, обозначающие детей и группы лиц: двое детей, трое ребят */
+var collectivePersonNouns = str.NewWordSet([]string{
+	"дети", "ребенок", "ребёнок", "ребята", "близнецы", "сирота", "малыш", "внук", "внучка",
+})
+
+var ordinalUnits = []string{
+	"нулевой", "первый", "второй", "третий", "четвёртый", "пятый", "шестой", "седьмой", "восьмой", "девятый",
+	"десятый", "одиннадцатый", "двенадцатый", "тринадцатый", "четырнадцатый",
+	"пятнадцатый", "шестнадцатый", "семнадцатый", "восемнадцатый", "девятнадцатый",
+}
+
+var ordinalTens = []string{
+	"", "", "двадцатый", "тридцатый", "сороковой", "пятидесятый", "шестидесятый", "семидесятый", "восьмидесятый", "девяностый",
+}
+
+var ordinalHundreds = []string{
+	"", "сотый", "двухсотый", "трёхсотый", "четырёхсотый", "пятисотый", "шестисотый", "семисотый", "восьмисотый", "девятисотый",
+}
+
+/**
+ * Формы порядкового числительного "третий", которое склоняется не по общим правилам.
+ * Порядок: именительный, родительный, дательный, винительный, творительный, предложный.
+ */
+var thirdOrdinal = map[gender.Gender][]string{
+	gender.Male:   {"третий", "третьего", "третьему", "третий", "третьим", "третьем"},
+	gender.Female: {"третья", "третьей", "третьей", "третью", "третьей", "третьей"},
+	gender.Neuter: {"третье", "третьего", "третьему", "третье", "третьим", "третьем"},
+}
+
+var thirdOrdinalPlural = []string{"третьи", "третьих", "третьим", "третьи", "третьими", "третьих"}
+
+/**
+ * Формы первой части сложных порядковых числительных: двух-, ста-, девяносто-тысячный.
+ */
+var compoundOrdinalPrefixes = map[string]string{
+	"одного":    "одно",
+	"ста":       "сто",
+	"девяноста": "девяносто",
+}
+
+/**
+ * Полтора (полторы) - число 1,5.
+ * Порядок: именительный, родительный, дательный, винительный, творительный, предложный.
+ */
+var oneAndHalf = map[gender.Gender][]string{
+	gender.Male:   {"полтора", "полутора", "полутора", "полтора", "полутора", "полутора"},
+	gender.Female: {"полторы", "полутора", "полутора", "полторы", "полутора", "полутора"},
+}
@@ -0,0 +1,164 @@
+package numeral
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian/adjective"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение дроби во всех 6 падежах: одна вторая, три пятых, трём пятым.
+ * Существительное после дроби стоит в родительном падеже единственного числа: три пятых метра.
+ * @param int $numerator Числитель
+ * @param int $denominator Знаменатель
+ * @return string[]
+ * @throws \Exception
+ */
+func GetFractionCases(numerator, denominator int64) (cases.Cases, error) {
+	if denominator <= 0 {
+		return nil, errors.New("denominator must be positive")
+	}
+	return agreeWithFractional(numerator, GetOrdinalCases(denominator, gender.Female, false), GetOrdinalPluralCases(denominator, false)), nil
+}
+
+/**
+ * Получение десятичной дроби во всех 6 падежах: две целых пять десятых, двух целых пяти десятых.
+ * Целое число записывается количественным числительным.
+ * @param string $number Число с разделителем "," или "."
+ * @return string[]
+ * @throws \Exception
+ */
+func GetDecimalCases(value string) (cases.Cases, error) {
+	value = strings.TrimSpace(value)
+	var sign string
+	if strings.HasPrefix(value, "-") {
+		sign = "минус "
+		value = value[1:]
+	}
+
+	integer, fraction, digits, err := parseDecimal(value)
+	if err != nil {
+		return nil, err
+	}
+	if digits == 0 {
+		return addPrefix(sign, GetCardinalCases(integer, gender.Female, false)), nil
+	}
+
+	whole, _ := adjective.GetCases(str.Word("целый"), false, gender.Female)
+	wholePlural, _ := adjective.GetPluralCases(str.Word("целый"), false)
+	integerForms := agreeWithFractional(integer, whole, wholePlural)
+
+	denominator := int64(1)
+	for i := 0; i < digits; i++ {
+		denominator *= 10
+	}
+	fractionForms, err := GetFractionCases(fraction, denominator)
+	if err != nil {
+		return nil, err
+	}
+
+	result := cases.NewCases()
+	for _, cCase := range caseOrder {
+		result[cCase] = integerForms[cCase] + " " + fractionForms[cCase]
+	}
+	return addPrefix(sign, result), nil
+}
+
+/**
+ * Получение числительного "полтора" ("полторы" для женского рода) во всех 6 падежах.
+ * @param string $gender Род существительного
+ * @return string[]
+ */
+func GetOneAndHalfCases(gendr gender.Gender) cases.Cases {
+	forms := oneAndHalf[gender.Male]
+	if gendr == gender.Female {
+		forms = oneAndHalf[gender.Female]
+	}
+
+	result := cases.NewCases()
+	for ind, cCase := range caseOrder {
+		result[cCase] = forms[ind]
+	}
+	return result
+}
+
+/**
+ * Согласование количественного числительного женского рода со словом, обозначающим долю (целая, пятая):
+ * одна пятая, две пятых, двум пятым.
+ * @param int $count
+ * @param string[] $singular Формы слова в единственном числе женского рода
+ * @param string[] $plural Формы слова во множественном числе
+ * @return string[]
+ */
+func agreeWithFractional(count int64, singular, plural cases.Cases) cases.Cases {
+	countForms := GetCardinalCases(count, gender.Female, false)
+	form := declension.GetNumeralForm(count)
+
+	result := cases.NewCases()
+	for _, cCase := range caseOrder {
+		switch {
+		case form == declension.One:
+			result[cCase] = countForms[cCase] + " " + singular[cCase]
+		case cCase == cases.Imenit || cCase == cases.Vinit:
+			result[cCase] = countForms[cCase] + " " + plural[cases.Rodit]
+		default:
+			result[cCase] = countForms[cCase] + " " + plural[cCase]
+		}
+	}
+	return result
+}
+
+/**
+ * Разбор десятичной дроби: "2,05" - целая часть 2, дробная часть 5, 2 знака после запятой.
+ * @param string $number
+ * @return int Целая часть
+ * @return int Дробная часть
+ * @return int Количество знаков после запятой
+ * @throws \Exception
+ */
+func parseDecimal(value string) (int64, int64, int, error) {
+	invalid := errors.New("invalid decimal number " + value)
+	parts := strings.Split(strings.Replace(value, ",", ".", 1), ".")
+	if len(parts) > 2 || !isDigits(parts[0]) {
+		return 0, 0, 0, invalid
+	}
+
+	integer, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, 0, invalid
+	}
+	if len(parts) == 1 || parts[1] == "" {
+		return integer, 0, 0, nil
+	}
+
+	if len(parts[1]) > 18 || !isDigits(parts[1]) {
+		return 0, 0, 0, invalid
+	}
+	fraction, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, 0, invalid
+	}
+	return integer, fraction, len(parts[1]), nil
+}
+
+/**
+ * @param string $string
+ * @return bool
+ */
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package numeral
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetFractionCases(t *testing.T) {
+	tests := []struct {
+		Numerator   int64
+		Denominator int64
+		Cases       cases.Cases
+	}{
+		{
+			Numerator:   1,
+			Denominator: 2,
+			Cases: cases.Cases{
+				cases.Imenit:  "одна вторая",
+				cases.Rodit:   "одной второй",
+				cases.Dat:     "одной второй",
+				cases.Vinit:   "одну вторую",
+				cases.Tvorit:  "одной второй",
+				cases.Predloj: "одной второй",
+			},
+		},
+		{
+			Numerator:   3,
+			Denominator: 5,
+			Cases: cases.Cases{
+				cases.Imenit:  "три пятых",
+				cases.Rodit:   "трёх пятых",
+				cases.Dat:     "трём пятым",
+				cases.Vinit:   "три пятых",
+				cases.Tvorit:  "тремя пятыми",
+				cases.Predloj: "трёх пятых",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Cases[cases.Imenit], func(t *testing.T) {
+			forms, err := GetFractionCases(tst.Numerator, tst.Denominator)
+			require.NoError(t, err)
+			assert.Equal(t, tst.Cases, forms)
+		})
+	}
+
+	_, err := GetFractionCases(1, 0)
+	assert.Error(t, err)
+}
+
+func Test_GetDecimalCases(t *testing.T) {
+	tests := []struct {
+		Value string
+		Cases cases.Cases
+	}{
+		{
+			Value: "2,5",
+			Cases: cases.Cases{
+				cases.Imenit:  "две целых пять десятых",
+				cases.Rodit:   "двух целых пяти десятых",
+				cases.Dat:     "двум целым пяти десятым",
+				cases.Vinit:   "две целых пять десятых",
+				cases.Tvorit:  "двумя целыми пятью десятыми",
+				cases.Predloj: "двух целых пяти десятых",
+			},
+		},
+		{
+			Value: "-1.05",
+			Cases: cases.Cases{
+				cases.Imenit:  "минус одна целая пять сотых",
+				cases.Rodit:   "минус одной целой пяти сотых",
+				cases.Dat:     "минус одной целой пяти сотым",
+				cases.Vinit:   "минус одну целую пять сотых",
+				cases.Tvorit:  "минус одной целой пятью сотыми",
+				cases.Predloj: "минус одной целой пяти сотых",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Value, func(t *testing.T) {
+			forms, err := GetDecimalCases(tst.Value)
+			require.NoError(t, err)
+			assert.Equal(t, tst.Cases, forms)
+		})
+	}
+
+	forms, err := GetDecimalCases("3")
+	require.NoError(t, err)
+	assert.Equal(t, "три", forms[cases.Imenit])
+
+	for _, value := range []string{"", "2,5,1", "2.x", "1.-5", ".5"} {
+		_, err := GetDecimalCases(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func Test_GetOneAndHalfCases(t *testing.T) {
+	assert.Equal(t, "полтора", GetOneAndHalfCases(gender.Male)[cases.Imenit])
+	assert.Equal(t, "полторы", GetOneAndHalfCases(gender.Female)[cases.Vinit])
+	assert.Equal(t, "полутора", GetOneAndHalfCases(gender.Female)[cases.Tvorit])
+}
@@ -0,0 +1,172 @@
+package numeral
+
+import (
+	"math"
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/russian/adjective"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение порядкового числительного во всех 6 падежах:
+ * двадцать пятый, двадцать пятого, ...; две тысячи двадцать шестой; двухтысячный.
+ * Изменяется только последнее слово, остальные остаются в именительном падеже.
+ * @param int $number
+ * @param string $gender Род существительного, с которым согласуется числительное
+ * @param bool $animateness Признак одушевленности существительного
+ * @return string[]
+ */
+func GetOrdinalCases(n int64, gendr gender.Gender, animateness bool) cases.Cases {
+	prefix, word := getOrdinalParts(n)
+
+	var forms cases.Cases
+	if word == "третий" {
+		forms = cases.NewCases()
+		for ind, cCase := range caseOrder {
+			forms[cCase] = thirdOrdinal[gendr][ind]
+		}
+		if gendr == gender.Male {
+			forms[cases.Vinit] = russian.GetVinitCaseByAnimateness(forms, animateness)
+		}
+	} else {
+		forms, _ = adjective.GetCases(str.Word(word), animateness, gendr)
+	}
+	return addPrefix(prefix, forms)
+}
+
+/**
+ * Получение порядкового числительного во множественном числе во всех 6 падежах: пятые, пятых, ...
+ * @param int $number
+ * @param bool $animateness Признак одушевленности существительного
+ * @return string[]
+ */
+func GetOrdinalPluralCases(n int64, animateness bool) cases.Cases {
+	prefix, word := getOrdinalParts(n)
+
+	var forms cases.Cases
+	if word == "третий" {
+		forms = cases.NewCases()
+		for ind, cCase := range caseOrder {
+			forms[cCase] = thirdOrdinalPlural[ind]
+		}
+		forms[cases.Vinit] = russian.GetVinitCaseByAnimateness(forms, animateness)
+	} else {
+		forms, _ = adjective.GetPluralCases(str.Word(word), animateness)
+	}
+	return addPrefix(prefix, forms)
+}
+
+/**
+ * Получение одной формы порядкового числительного (падежа).
+ * @param int $number
+ * @param string $case Падеж
+ * @param string $gender Род
+ * @param bool $animateness Признак одушевленности
+ * @return string
+ */
+func GetOrdinalCase(n int64, wCase string, gendr gender.Gender, animateness bool) string {
+	cCase := cases.CanonizeCase(wCase)
+	return GetOrdinalCases(n, gendr, animateness)[cCase]
+}
+
+/**
+ * Разбиение порядкового числительного на неизменяемую часть (количественное числительное)
+ * и последнее слово в начальной форме: 125 - "сто двадцать" и "пятый".
+ * @param int $number
+ * @return string Неизменяемая часть
+ * @return string Последнее слово
+ */
+func getOrdinalParts(n int64) (string, string) {
+	// -math.MinInt64 переполняется; число оканчивается на 8: минус ... восьмой
+	if n == math.MinInt64 {
+		return GetCardinalCases(n+8, gender.Male, false)[cases.Imenit] + " ", ordinalUnits[8]
+	}
+
+	var sign string
+	if n < 0 {
+		sign = "минус "
+		n = -n
+	}
+
+	var last int64
+	var word string
+	rest := n % 1000
+	switch {
+	case n == 0:
+		return "", ordinalUnits[0]
+
+	case rest == 0:
+		// круглые тысячи, миллионы: тысячный, двухтысячный, трёхмиллионный
+		for ind := len(exponents) - 1; ind >= 0; ind-- {
+			exp := exponents[ind]
+			if n%exp.Value != 0 || (ind > 0 && n%exponents[ind-1].Value == 0) {
+				continue
+			}
+			count := n / exp.Value % 1000
+			last = count * exp.Value
+			word = getCompoundOrdinalPrefix(count) + strings.TrimSuffix(exp.Word, "а") + "ный"
+			break
+		}
+
+	case rest%100 == 0:
+		last = rest
+		word = ordinalHundreds[rest/100]
+
+	case rest%100 < 20:
+		last = rest % 100
+		word = ordinalUnits[last]
+
+	case rest%10 == 0:
+		last = rest % 100
+		word = ordinalTens[last/10]
+
+	default:
+		last = rest % 10
+		word = ordinalUnits[last]
+	}
+
+	if n == last {
+		return sign, word
+	}
+	prefix := GetCardinalCases(n-last, gender.Male, false)[cases.Imenit]
+	// миллион первый, тысяча сотый: единица перед тысячей, миллионом не называется
+	if n-last >= 1000 {
+		prefix = strings.TrimPrefix(strings.TrimPrefix(prefix, "один "), "одна ")
+	}
+	return sign + prefix + " ", word
+}
+
+/**
+ * Первая часть сложного порядкового числительного: двух(тысячный), двадцатиодно(тысячный).
+ * @param int $count
+ * @return string
+ */
+func getCompoundOrdinalPrefix(count int64) string {
+	if count == 1 {
+		return ""
+	}
+
+	words := strings.Fields(GetCardinalCases(count, gender.Male, false)[cases.Rodit])
+	for ind, word := range words {
+		if prefix, has := compoundOrdinalPrefixes[word]; has {
+			words[ind] = prefix
+		}
+	}
+	return strings.Join(words, "")
+}
+
+/**
+ * @param string $prefix
+ * @param string[] $forms
+ * @return string[]
+ */
+func addPrefix(prefix string, forms cases.Cases) cases.Cases {
+	for cCase, form := range forms {
+		forms[cCase] = prefix + form
+	}
+	return forms
+}
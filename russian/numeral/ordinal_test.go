@@ -0,0 +1,99 @@
+package numeral
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOrdinalCases(t *testing.T) {
+	tests := []struct {
+		Number int64
+		Gender gender.Gender
+		Cases  cases.Cases
+	}{
+		{
+			Number: 3,
+			Gender: gender.Female,
+			Cases: cases.Cases{
+				cases.Imenit:  "третья",
+				cases.Rodit:   "третьей",
+				cases.Dat:     "третьей",
+				cases.Vinit:   "третью",
+				cases.Tvorit:  "третьей",
+				cases.Predloj: "третьей",
+			},
+		},
+		{
+			Number: 2026,
+			Gender: gender.Male,
+			Cases: cases.Cases{
+				cases.Imenit:  "две тысячи двадцать шестой",
+				cases.Rodit:   "две тысячи двадцать шестого",
+				cases.Dat:     "две тысячи двадцать шестому",
+				cases.Vinit:   "две тысячи двадцать шестой",
+				cases.Tvorit:  "две тысячи двадцать шестым",
+				cases.Predloj: "две тысячи двадцать шестом",
+			},
+		},
+		{
+			Number: 40,
+			Gender: gender.Neuter,
+			Cases: cases.Cases{
+				cases.Imenit:  "сороковое",
+				cases.Rodit:   "сорокового",
+				cases.Dat:     "сороковому",
+				cases.Vinit:   "сороковое",
+				cases.Tvorit:  "сороковым",
+				cases.Predloj: "сороковом",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Cases[cases.Imenit], func(t *testing.T) {
+			assert.Equal(t, tst.Cases, GetOrdinalCases(tst.Number, tst.Gender, false))
+		})
+	}
+}
+
+func Test_GetOrdinalCase(t *testing.T) {
+	tests := []struct {
+		Number int64
+		Result string
+	}{
+		{Number: 0, Result: "нулевой"},
+		{Number: 18, Result: "восемнадцатый"},
+		{Number: 125, Result: "сто двадцать пятый"},
+		{Number: 300, Result: "трёхсотый"},
+		{Number: 1000, Result: "тысячный"},
+		{Number: 2000, Result: "двухтысячный"},
+		{Number: 21000, Result: "двадцатиоднотысячный"},
+		{Number: 100000, Result: "стотысячный"},
+		{Number: 5000000, Result: "пятимиллионный"},
+		{Number: 1000001, Result: "миллион первый"},
+		{Number: 1100, Result: "тысяча сотый"},
+		{Number: 21000001, Result: "двадцать один миллион первый"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Result, func(t *testing.T) {
+			assert.Equal(t, tst.Result, GetOrdinalCase(tst.Number, "именительный", gender.Male, false))
+		})
+	}
+}
+
+func Test_GetOrdinalCase_MinInt64(t *testing.T) {
+	result := GetOrdinalCase(math.MinInt64, "именительный", gender.Male, false)
+	assert.True(t, strings.HasPrefix(result, "минус девять квинтиллионов"))
+	assert.True(t, strings.HasSuffix(result, "семьсот семьдесят пять тысяч восемьсот восьмой"))
+}
+
+func Test_GetOrdinalPluralCases(t *testing.T) {
+	assert.Equal(t, "пятых", GetOrdinalPluralCases(5, false)[cases.Rodit])
+	assert.Equal(t, "третьими", GetOrdinalPluralCases(3, false)[cases.Tvorit])
+}
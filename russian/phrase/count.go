@@ -0,0 +1,193 @@
+package phrase
+
+import (
+	"github.com/dshipenok/gomorphos/russian/adjective"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/russian/numeral"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение формы словосочетания, стоящего после числа (без самого числа):
+ * 1 новая задача, 22 новые задачи, 5 новых задач, 22 новых стола.
+ *
+ * После 2-4 в именительном падеже существительное стоит в родительном падеже единственного числа,
+ * прилагательное - в родительном падеже множественного числа (два новых стола),
+ * а при существительных женского рода - в именительном падеже множественного числа (две новые задачи).
+ *
+ * @param int $count
+ * @param string $phrase
+ * @param bool $animateness
+ * @param string $case
+ * @return string
+ * @throws \Exception
+ */
+func Pluralize(count int64, phrase string, animateness bool, cCase cases.Case) (string, error) {
+	words, head, err := parse(phrase)
+	if err != nil {
+		return phrase, err
+	}
+
+	noun := str.Word(words[head])
+	gendr := DetectGender(noun)
+	form := declension.GetNumeralForm(count)
+	pluralOnly := declension.IsPluraliaTantum(noun)
+
+	forms := make([]cases.Cases, len(words))
+	for i, word := range words {
+		if i == head {
+			forms[i] = cases.Cases{cCase: declension.Pluralize(noun, count, animateness, cCase)}
+			continue
+		}
+
+		adj := str.Word(word)
+		var adjForms cases.Cases
+		if form == declension.One && !pluralOnly {
+			adjForms, err = adjective.GetCases(adj, animateness, gendr)
+		} else {
+			adjForms, err = adjective.GetPluralCases(getSingularAdjective(adj), animateness)
+		}
+		if err != nil {
+			return phrase, err
+		}
+
+		adjCase := cCase
+		if form != declension.One && (cCase == cases.Imenit || cCase == cases.Vinit) {
+			switch {
+			case cCase == cases.Vinit && animateness && declension.IsSimpleTwoFour(count):
+				// вижу двух новых пользователей
+			case form == declension.TwoFour && gendr == gender.Female && !pluralOnly:
+				adjCase = cases.Imenit // две новые задачи
+			default:
+				adjCase = cases.Rodit // два новых стола, пять новых задач
+			}
+		}
+		forms[i] = cases.Cases{cCase: adjForms[adjCase]}
+	}
+
+	return joinCases(forms)[cCase], nil
+}
+
+/**
+ * Получение словосочетания с числительным, записанным словами, во всех 6 падежах:
+ * двадцать две новые задачи, двадцати двух новых задач, ...
+ * @param int $count
+ * @param string $phrase
+ * @param bool $animateness
+ * @return string[]
+ * @throws \Exception
+ */
+func GetCountCases(count int64, phrase string, animateness bool) (cases.Cases, error) {
+	words, head, err := parse(phrase)
+	if err != nil {
+		return nil, err
+	}
+
+	noun := str.Word(words[head])
+	var numeralForms cases.Cases
+	if declension.IsPluraliaTantum(noun) {
+		// двое суток, трое ножниц, одни сутки
+		numeralForms = numeral.GetPluraliaTantumCases(count, animateness)
+	} else {
+		numeralForms = numeral.GetCardinalCases(count, DetectGender(noun), animateness)
+	}
+
+	return joinNumeralCases(numeralForms, count, phrase, animateness)
+}
+
+/**
+ * Получение одной формы словосочетания с числительным (падежа).
+ * @param int $count
+ * @param string $phrase
+ * @param string $case
+ * @param bool $animateness
+ * @return string
+ * @throws \Exception
+ */
+func GetCountCase(count int64, phrase string, wCase string, animateness bool) (string, error) {
+	cCase := cases.CanonizeCase(wCase)
+	forms, err := GetCountCases(count, phrase, animateness)
+	if err != nil {
+		return phrase, err
+	}
+	return forms[cCase], nil
+}
+
+/**
+ * После собирательного числительного словосочетание согласуется так же, как после "пять":
+ * двое новых гостей, двоим новым гостям.
+ */
+const collectiveAgreementCount = 5
+
+/**
+ * Получение словосочетания с собирательным числительным во всех 6 падежах:
+ * трое новых гостей, троих новых гостей, троим новым гостям, ...
+ * @param int $count Число от 2 до 10
+ * @param string $phrase
+ * @param bool $animateness
+ * @return string[]
+ * @throws \Exception
+ */
+func GetCollectiveCountCases(count int64, phrase string, animateness bool) (cases.Cases, error) {
+	numeralForms, err := numeral.GetCollectiveCases(count, animateness)
+	if err != nil {
+		return cases.NewCasesWord(str.Word(phrase)), err
+	}
+	return joinNumeralCases(numeralForms, collectiveAgreementCount, phrase, animateness)
+}
+
+/**
+ * Получение словосочетания с числительным "оба/обе" во всех 6 падежах:
+ * оба новых стола, обе новые задачи, обоих новых гостей, ...
+ * @param string $phrase
+ * @param bool $animateness
+ * @return string[]
+ * @throws \Exception
+ */
+func GetBothCases(phrase string, animateness bool) (cases.Cases, error) {
+	words, head, err := parse(phrase)
+	if err != nil {
+		return nil, err
+	}
+
+	numeralForms := numeral.GetBothCases(DetectGender(str.Word(words[head])), animateness)
+	// оба согласуется с существительным так же, как два
+	return joinNumeralCases(numeralForms, 2, phrase, animateness)
+}
+
+/**
+ * Объединение форм числительного с формами словосочетания, согласованного с числом.
+ * @param string[] $numeralForms
+ * @param int $count
+ * @param string $phrase
+ * @param bool $animateness
+ * @return string[]
+ * @throws \Exception
+ */
+func joinNumeralCases(numeralForms cases.Cases, count int64, phrase string, animateness bool) (cases.Cases, error) {
+	result := cases.NewCases()
+	for _, cCase := range caseOrder {
+		phraseCase := cCase
+		// тысяча, миллион управляют словосочетанием как существительные: двумя тысячами новых задач
+		if isRoundThousands(count) {
+			phraseCase = cases.Rodit
+		}
+		phraseForm, err := Pluralize(count, phrase, animateness, phraseCase)
+		if err != nil {
+			return cases.NewCasesWord(str.Word(phrase)), err
+		}
+		result[cCase] = numeralForms[cCase] + " " + phraseForm
+	}
+	return result, nil
+}
+
+/**
+ * Проверка, оканчивается ли число на тысячу, миллион, миллиард: 1000, 2000, 3000000.
+ * @param int $count
+ * @return bool
+ */
+func isRoundThousands(count int64) bool {
+	return count != 0 && count%1000 == 0
+}
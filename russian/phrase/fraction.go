@@ -0,0 +1,101 @@
+package phrase
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/russian/numeral"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение словосочетания с дробью во всех 6 падежах: три пятых метра, трём пятым метра.
+ * @param int $numerator Числитель
+ * @param int $denominator Знаменатель
+ * @param string $phrase
+ * @param bool $animateness
+ * @return string[]
+ * @throws \Exception
+ */
+func GetFractionCountCases(numerator, denominator int64, phrase string, animateness bool) (cases.Cases, error) {
+	numeralForms, err := numeral.GetFractionCases(numerator, denominator)
+	if err != nil {
+		return cases.NewCasesWord(str.Word(phrase)), err
+	}
+	return joinFractionalCases(numeralForms, phrase, animateness)
+}
+
+/**
+ * Получение словосочетания с десятичной дробью во всех 6 падежах:
+ * две целых пять десятых килограмма, двух целых пяти десятых килограмма.
+ * Для целого числа согласование такое же, как в GetCountCases: два килограмма.
+ * @param string $number Число с разделителем "," или "."
+ * @param string $phrase
+ * @param bool $animateness
+ * @return string[]
+ * @throws \Exception
+ */
+func GetDecimalCountCases(value string, phrase string, animateness bool) (cases.Cases, error) {
+	if !strings.ContainsAny(value, ".,") {
+		count, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return cases.NewCasesWord(str.Word(phrase)), err
+		}
+		return GetCountCases(count, phrase, animateness)
+	}
+
+	numeralForms, err := numeral.GetDecimalCases(value)
+	if err != nil {
+		return cases.NewCasesWord(str.Word(phrase)), err
+	}
+	return joinFractionalCases(numeralForms, phrase, animateness)
+}
+
+/**
+ * Получение словосочетания с числительным "полтора" во всех 6 падежах:
+ * полтора часа, полторы минуты, полутора часов.
+ * @param string $phrase
+ * @param bool $animateness
+ * @return string[]
+ * @throws \Exception
+ */
+func GetOneAndHalfCountCases(phrase string, animateness bool) (cases.Cases, error) {
+	words, head, err := parse(phrase)
+	if err != nil {
+		return nil, err
+	}
+
+	numeralForms := numeral.GetOneAndHalfCases(DetectGender(str.Word(words[head])))
+	// полтора согласуется с существительным так же, как два
+	result, err := joinNumeralCases(numeralForms, 2, phrase, animateness)
+	if err != nil {
+		return result, err
+	}
+	// но винительный падеж совпадает с именительным и у одушевленных: вижу полтора человека
+	result[cases.Vinit] = result[cases.Imenit]
+	return result, nil
+}
+
+/**
+ * После дроби словосочетание стоит в родительном падеже единственного числа во всех падежах:
+ * две целых пять десятых килограмма, двум целым пяти десятым килограмма.
+ * @param string[] $numeralForms
+ * @param string $phrase
+ * @param bool $animateness
+ * @return string[]
+ * @throws \Exception
+ */
+func joinFractionalCases(numeralForms cases.Cases, phrase string, animateness bool) (cases.Cases, error) {
+	phraseForms, err := GetCases(phrase, animateness, number.Singular)
+	if err != nil {
+		return cases.NewCasesWord(str.Word(phrase)), err
+	}
+
+	result := cases.NewCases()
+	for _, cCase := range caseOrder {
+		result[cCase] = numeralForms[cCase] + " " + phraseForms[cases.Rodit]
+	}
+	return result, nil
+}
@@ -0,0 +1,206 @@
+package phrase
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/russian/adjective"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+var caseOrder = []cases.Case{cases.Imenit, cases.Rodit, cases.Dat, cases.Vinit, cases.Tvorit, cases.Predloj}
+
+/**
+ * Склонение словосочетания "прилагательные + существительное" (красная кнопка, новый пользователь).
+ *
+ * Главным словом считается последнее слово, не похожее на прилагательное;
+ * остальные слова согласуются с ним в роде, числе и падеже.
+ *
+ * @param string $phrase
+ * @param bool $animateness Признак одушевленности
+ * @param string $number Число
+ *
+ * @return string[]
+ * @throws \Exception
+ */
+func GetCases(phrase string, animateness bool, num number.Number) (cases.Cases, error) {
+	words, head, err := parse(phrase)
+	if err != nil {
+		return nil, err
+	}
+
+	noun := str.Word(words[head])
+	gendr := DetectGender(noun)
+	// число задается самим существительным: новые брюки, свежее молоко
+	switch {
+	case declension.IsPluraliaTantum(noun):
+		num = number.Plural
+	case declension.IsSingulariaTantum(noun):
+		num = number.Singular
+	}
+
+	var nounForms map[cases.Case]string
+	if num == number.Plural {
+		nounForms = declension.GetPluralCases(noun, animateness)
+	} else {
+		nounForms = declension.GetCases(noun, animateness)
+	}
+
+	forms := make([]cases.Cases, len(words))
+	for i, word := range words {
+		if i == head {
+			forms[i] = nounForms
+			continue
+		}
+
+		adjForms, err := getAdjectiveCases(str.Word(word), animateness, gendr, num)
+		if err != nil {
+			return cases.NewCasesWord(str.Word(phrase)), err
+		}
+		agreeVinit(adjForms, nounForms)
+		forms[i] = adjForms
+	}
+
+	return joinCases(forms), nil
+}
+
+/**
+ * Получение одной формы словосочетания (падежа).
+ * @param string $phrase
+ * @param string $case Падеж
+ * @param bool $animateness Признак одушевленности
+ * @param string $number Число
+ *
+ * @return string
+ * @throws \Exception
+ */
+func GetCase(phrase string, wCase string, animateness bool, num number.Number) (string, error) {
+	cCase := cases.CanonizeCase(wCase)
+	forms, err := GetCases(phrase, animateness, num)
+	if err != nil {
+		return phrase, err
+	}
+	return forms[cCase], nil
+}
+
+/**
+ * Определение рода главного слова словосочетания.
+ * @param string $noun
+ * @return string
+ */
+func DetectGender(noun str.Word) gender.Gender {
+	if russian.IsAdjectiveNoun(noun.Lower()) {
+		return adjective.DetectGender(noun, nil)
+	}
+	return declension.DetectGender(noun)
+}
+
+/**
+ * Разбиение словосочетания на слова и поиск главного слова.
+ * @param string $phrase
+ * @return array
+ * @throws \Exception
+ */
+func parse(phrase string) ([]string, int, error) {
+	words := strings.Fields(phrase)
+	if len(words) == 0 {
+		return nil, 0, errors.New("empty phrase")
+	}
+	return words, findHeadNoun(words), nil
+}
+
+/**
+ * Объединение форм отдельных слов в формы словосочетания.
+ * @param string[][] $forms
+ * @return string[]
+ */
+func joinCases(forms []cases.Cases) cases.Cases {
+	result := cases.NewCases()
+	for _, cCase := range caseOrder {
+		parts := make([]string, len(forms))
+		for i, f := range forms {
+			parts[i] = f[cCase]
+		}
+		result[cCase] = strings.Join(parts, " ")
+	}
+	return result
+}
+
+/**
+ * Поиск главного слова: последнее слово, не являющееся прилагательным.
+ * Если все слова похожи на прилагательные (субстантивированное прилагательное), главное - последнее.
+ * @param string[] $words
+ * @return int
+ */
+func findHeadNoun(words []string) int {
+	for i := len(words) - 1; i >= 0; i-- {
+		if !isAdjective(str.Word(words[i])) {
+			return i
+		}
+	}
+	return len(words) - 1
+}
+
+/**
+ * @param string $word
+ * @return bool
+ */
+func isAdjective(w str.Word) bool {
+	w = w.Lower()
+	return adjective.DetectGender(w, nil) != gender.Invalid && russian.IsAdjectiveNoun(w)
+}
+
+/**
+ * @param string $adjective
+ * @param bool $animateness
+ * @param string $gender
+ * @param string $number
+ * @return string[]
+ */
+func getAdjectiveCases(w str.Word, animateness bool, gendr gender.Gender, num number.Number) (cases.Cases, error) {
+	if num == number.Plural {
+		return adjective.GetPluralCases(getSingularAdjective(w), animateness)
+	}
+	return adjective.GetCases(w, animateness, gendr)
+}
+
+/**
+ * Винительный падеж прилагательного берется из того же падежа, что и у существительного:
+ * новые брюки (как именительный) даже при признаке одушевленности, новых пользователей (как родительный).
+ * @param string[] $adjForms
+ * @param string[] $nounForms
+ */
+func agreeVinit(adjForms cases.Cases, nounForms map[cases.Case]string) {
+	vinit := nounForms[cases.Vinit]
+	switch {
+	case vinit == nounForms[cases.Imenit] && vinit != nounForms[cases.Rodit]:
+		adjForms[cases.Vinit] = adjForms[cases.Imenit]
+	case vinit == nounForms[cases.Rodit] && vinit != nounForms[cases.Imenit]:
+		adjForms[cases.Vinit] = adjForms[cases.Rodit]
+	}
+}
+
+/**
+ * Прилагательное при существительном, имеющем только множественное число, стоит во множественном числе:
+ * новые брюки - новый. Для склонения берется форма мужского рода.
+ * @param string $adjective
+ * @return string
+ */
+func getSingularAdjective(w str.Word) str.Word {
+	lower := w.Lower()
+	if adjective.DetectGender(lower, nil) != gender.Invalid {
+		return w
+	}
+	switch lower.LastChars(2) {
+	case "ые":
+		return str.Word(w.SliceWord(0, -2).Concat("ый"))
+	case "ие":
+		return str.Word(w.SliceWord(0, -2).Concat("ий"))
+	}
+	return w
+}
@@ -0,0 +1,358 @@
+package phrase
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetCases(t *testing.T) {
+	tests := []struct {
+		Phrase      string
+		Animateness bool
+		Number      number.Number
+		Cases       cases.Cases
+	}{
+		{
+			Phrase: "красная кнопка",
+			Cases: cases.Cases{
+				cases.Imenit:  "красная кнопка",
+				cases.Rodit:   "красной кнопки",
+				cases.Dat:     "красной кнопке",
+				cases.Vinit:   "красную кнопку",
+				cases.Tvorit:  "красной кнопкой",
+				cases.Predloj: "красной кнопке",
+			},
+		},
+		{
+			Phrase:      "новый пользователь",
+			Animateness: true,
+			Cases: cases.Cases{
+				cases.Imenit:  "новый пользователь",
+				cases.Rodit:   "нового пользователя",
+				cases.Dat:     "новому пользователю",
+				cases.Vinit:   "нового пользователя",
+				cases.Tvorit:  "новым пользователем",
+				cases.Predloj: "новом пользователе",
+			},
+		},
+		{
+			Phrase:      "новый пользователь",
+			Animateness: true,
+			Number:      number.Plural,
+			Cases: cases.Cases{
+				cases.Imenit:  "новые пользователи",
+				cases.Rodit:   "новых пользователей",
+				cases.Dat:     "новым пользователям",
+				cases.Vinit:   "новых пользователей",
+				cases.Tvorit:  "новыми пользователями",
+				cases.Predloj: "новых пользователях",
+			},
+		},
+		{
+			Phrase: "новое окно",
+			Number: number.Plural,
+			Cases: cases.Cases{
+				cases.Imenit:  "новые окна",
+				cases.Rodit:   "новых окон",
+				cases.Dat:     "новым окнам",
+				cases.Vinit:   "новые окна",
+				cases.Tvorit:  "новыми окнами",
+				cases.Predloj: "новых окнах",
+			},
+		},
+		{
+			Phrase: "большая синяя кухня",
+			Cases: cases.Cases{
+				cases.Imenit:  "большая синяя кухня",
+				cases.Rodit:   "большой синей кухни",
+				cases.Dat:     "большой синей кухне",
+				cases.Vinit:   "большую синюю кухню",
+				cases.Tvorit:  "большой синей кухней",
+				cases.Predloj: "большой синей кухне",
+			},
+		},
+		{
+			Phrase: "новые брюки",
+			Cases: cases.Cases{
+				cases.Imenit:  "новые брюки",
+				cases.Rodit:   "новых брюк",
+				cases.Dat:     "новым брюкам",
+				cases.Vinit:   "новые брюки",
+				cases.Tvorit:  "новыми брюками",
+				cases.Predloj: "новых брюках",
+			},
+		},
+		{
+			Phrase:      "новые брюки",
+			Animateness: true,
+			Cases: cases.Cases{
+				cases.Imenit:  "новые брюки",
+				cases.Rodit:   "новых брюк",
+				cases.Dat:     "новым брюкам",
+				cases.Vinit:   "новые брюки",
+				cases.Tvorit:  "новыми брюками",
+				cases.Predloj: "новых брюках",
+			},
+		},
+		{
+			Phrase: "свежее молоко",
+			Number: number.Plural,
+			Cases: cases.Cases{
+				cases.Imenit:  "свежее молоко",
+				cases.Rodit:   "свежего молока",
+				cases.Dat:     "свежему молоку",
+				cases.Vinit:   "свежее молоко",
+				cases.Tvorit:  "свежим молоком",
+				cases.Predloj: "свежем молоке",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Phrase, func(t *testing.T) {
+			cases, err := GetCases(tst.Phrase, tst.Animateness, tst.Number)
+
+			require.NoError(t, err)
+			assert.Equal(t, tst.Cases, cases)
+		})
+	}
+}
+
+func Test_GetCase(t *testing.T) {
+	casedStr, err := GetCase("свежее молоко", "творительный", false, number.Singular)
+
+	require.NoError(t, err)
+	assert.Equal(t, "свежим молоком", casedStr)
+}
+
+func Test_GetCountCases(t *testing.T) {
+	tests := []struct {
+		Count       int64
+		Phrase      string
+		Animateness bool
+		Cases       cases.Cases
+	}{
+		{
+			Count:  22,
+			Phrase: "новая задача",
+			Cases: cases.Cases{
+				cases.Imenit:  "двадцать две новые задачи",
+				cases.Rodit:   "двадцати двух новых задач",
+				cases.Dat:     "двадцати двум новым задачам",
+				cases.Vinit:   "двадцать две новые задачи",
+				cases.Tvorit:  "двадцатью двумя новыми задачами",
+				cases.Predloj: "двадцати двух новых задачах",
+			},
+		},
+		{
+			Count:  1,
+			Phrase: "новая задача",
+			Cases: cases.Cases{
+				cases.Imenit:  "одна новая задача",
+				cases.Rodit:   "одной новой задачи",
+				cases.Dat:     "одной новой задаче",
+				cases.Vinit:   "одну новую задачу",
+				cases.Tvorit:  "одной новой задачей",
+				cases.Predloj: "одной новой задаче",
+			},
+		},
+		{
+			Count:       3,
+			Phrase:      "новый пользователь",
+			Animateness: true,
+			Cases: cases.Cases{
+				cases.Imenit:  "три новых пользователя",
+				cases.Rodit:   "трёх новых пользователей",
+				cases.Dat:     "трём новым пользователям",
+				cases.Vinit:   "трёх новых пользователей",
+				cases.Tvorit:  "тремя новыми пользователями",
+				cases.Predloj: "трёх новых пользователях",
+			},
+		},
+		{
+			Count:  2,
+			Phrase: "полные сутки",
+			Cases: cases.Cases{
+				cases.Imenit:  "двое полных суток",
+				cases.Rodit:   "двоих полных суток",
+				cases.Dat:     "двоим полным суткам",
+				cases.Vinit:   "двое полных суток",
+				cases.Tvorit:  "двоими полными сутками",
+				cases.Predloj: "двоих полных сутках",
+			},
+		},
+		{
+			Count:  2000,
+			Phrase: "новая задача",
+			Cases: cases.Cases{
+				cases.Imenit:  "две тысячи новых задач",
+				cases.Rodit:   "двух тысяч новых задач",
+				cases.Dat:     "двум тысячам новых задач",
+				cases.Vinit:   "две тысячи новых задач",
+				cases.Tvorit:  "двумя тысячами новых задач",
+				cases.Predloj: "двух тысячах новых задач",
+			},
+		},
+		{
+			Count:  1000,
+			Phrase: "новая задача",
+			Cases: cases.Cases{
+				cases.Imenit:  "одна тысяча новых задач",
+				cases.Rodit:   "одной тысячи новых задач",
+				cases.Dat:     "одной тысяче новых задач",
+				cases.Vinit:   "одну тысячу новых задач",
+				cases.Tvorit:  "одной тысячей новых задач",
+				cases.Predloj: "одной тысяче новых задач",
+			},
+		},
+		{
+			Count:       3000000,
+			Phrase:      "новый пользователь",
+			Animateness: true,
+			Cases: cases.Cases{
+				cases.Imenit:  "три миллиона новых пользователей",
+				cases.Rodit:   "трёх миллионов новых пользователей",
+				cases.Dat:     "трём миллионам новых пользователей",
+				cases.Vinit:   "три миллиона новых пользователей",
+				cases.Tvorit:  "тремя миллионами новых пользователей",
+				cases.Predloj: "трёх миллионах новых пользователей",
+			},
+		},
+		{
+			Count:  21,
+			Phrase: "ножницы",
+			Cases: cases.Cases{
+				cases.Imenit:  "двадцать одни ножницы",
+				cases.Rodit:   "двадцати одних ножниц",
+				cases.Dat:     "двадцати одним ножницам",
+				cases.Vinit:   "двадцать одни ножницы",
+				cases.Tvorit:  "двадцатью одними ножницами",
+				cases.Predloj: "двадцати одних ножницах",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Phrase, func(t *testing.T) {
+			cases, err := GetCountCases(tst.Count, tst.Phrase, tst.Animateness)
+
+			require.NoError(t, err)
+			assert.Equal(t, tst.Cases, cases)
+		})
+	}
+}
+
+func Test_Pluralize(t *testing.T) {
+	tests := []struct {
+		Count  int64
+		Phrase string
+		Result string
+	}{
+		{Count: 5, Phrase: "новая задача", Result: "новых задач"},
+		{Count: 2, Phrase: "новый стол", Result: "новых стола"},
+		{Count: 101, Phrase: "новое окно", Result: "новое окно"},
+		{Count: 1, Phrase: "новые брюки", Result: "новые брюки"},
+		{Count: 3, Phrase: "новые брюки", Result: "новых брюк"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Result, func(t *testing.T) {
+			result, err := Pluralize(tst.Count, tst.Phrase, false, cases.Imenit)
+
+			require.NoError(t, err)
+			assert.Equal(t, tst.Result, result)
+		})
+	}
+}
+
+func Test_GetCollectiveCountCases(t *testing.T) {
+	forms, err := GetCollectiveCountCases(3, "новый гость", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, cases.Cases{
+		cases.Imenit:  "трое новых гостей",
+		cases.Rodit:   "троих новых гостей",
+		cases.Dat:     "троим новым гостям",
+		cases.Vinit:   "троих новых гостей",
+		cases.Tvorit:  "троими новыми гостями",
+		cases.Predloj: "троих новых гостях",
+	}, forms)
+
+	_, err = GetCollectiveCountCases(12, "новый гость", true)
+	assert.Error(t, err)
+}
+
+func Test_GetBothCases(t *testing.T) {
+	forms, err := GetBothCases("новый гость", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, cases.Cases{
+		cases.Imenit:  "оба новых гостя",
+		cases.Rodit:   "обоих новых гостей",
+		cases.Dat:     "обоим новым гостям",
+		cases.Vinit:   "обоих новых гостей",
+		cases.Tvorit:  "обоими новыми гостями",
+		cases.Predloj: "обоих новых гостях",
+	}, forms)
+
+	forms, err = GetBothCases("новая задача", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, "обе новые задачи", forms[cases.Imenit])
+}
+
+func Test_GetFractionCountCases(t *testing.T) {
+	forms, err := GetFractionCountCases(3, 5, "метр", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, "три пятых метра", forms[cases.Imenit])
+	assert.Equal(t, "тремя пятыми метра", forms[cases.Tvorit])
+}
+
+func Test_GetDecimalCountCases(t *testing.T) {
+	forms, err := GetDecimalCountCases("2,5", "килограмм", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, cases.Cases{
+		cases.Imenit:  "две целых пять десятых килограмма",
+		cases.Rodit:   "двух целых пяти десятых килограмма",
+		cases.Dat:     "двум целым пяти десятым килограмма",
+		cases.Vinit:   "две целых пять десятых килограмма",
+		cases.Tvorit:  "двумя целыми пятью десятыми килограмма",
+		cases.Predloj: "двух целых пяти десятых килограмма",
+	}, forms)
+
+	forms, err = GetDecimalCountCases("2", "килограмм", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, "два килограмма", forms[cases.Imenit])
+}
+
+func Test_GetOneAndHalfCountCases(t *testing.T) {
+	forms, err := GetOneAndHalfCountCases("час", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, cases.Cases{
+		cases.Imenit:  "полтора часа",
+		cases.Rodit:   "полутора часов",
+		cases.Dat:     "полутора часам",
+		cases.Vinit:   "полтора часа",
+		cases.Tvorit:  "полутора часами",
+		cases.Predloj: "полутора часах",
+	}, forms)
+
+	forms, err = GetOneAndHalfCountCases("минута", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, "полторы минуты", forms[cases.Imenit])
+
+	forms, err = GetOneAndHalfCountCases("человек", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, "полтора человека", forms[cases.Imenit])
+	assert.Equal(t, "полтора человека", forms[cases.Vinit])
+}
@@ -0,0 +1,97 @@
+package preposition
+
+import (
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Предлоги с беглой гласной и их полные формы: в - во, с - со, к - ко, о - обо.
+ */
+var longForms = map[string]string{
+	"в":     "во",
+	"с":     "со",
+	"к":     "ко",
+	"о":     "обо",
+	"об":    "обо",
+	"из":    "изо",
+	"от":    "ото",
+	"под":   "подо",
+	"над":   "надо",
+	"перед": "передо",
+	"пред":  "предо",
+	"без":   "безо",
+}
+
+/**
+ * Первые согласные слова, перед сочетанием которых с другой согласной
+ * предлог получает беглую гласную: во власти, со стола.
+ */
+var clusterStarts = map[string]str.WordSet{
+	"в": str.NewWordSet([]string{"в", "ф"}),
+	"с": str.NewWordSet([]string{"с", "з", "ш", "ж", "щ"}),
+}
+
+/**
+ * Слова, перед которыми используется полная форма предлога независимо от общих правил.
+ */
+var longFormWords = map[string]str.WordSet{
+	"в": str.NewWordSet([]string{
+		"мне", "многом", "многих", "множестве", "сне", "рту", "лбу", "льду", "дни", "что",
+	}),
+	"с": str.NewWordSet([]string{
+		"мной", "мною", "многими", "многим", "всем", "всеми", "всех", "всего", "всей", "всё", "всею",
+		"льдом", "львом", "лба", "рта", "дна", "двора", "второго", "вторника", "вторым", "второй",
+	}),
+	"к": str.NewWordSet([]string{
+		"мне", "многим", "многому", "всему", "всем", "всей", "всякому", "всякой",
+		"второму", "второй", "вторнику", "дну", "льду", "рту",
+	}),
+	"о": str.NewWordSet([]string{
+		"мне", "всём", "всех", "всем", "всё", "всю", "вся", "что",
+	}),
+	"из":    str.NewWordSet([]string{"рта", "льда", "дня", "всех", "всего", "всей", "всякой"}),
+	"от":    str.NewWordSet([]string{"всех", "всего", "всей", "всякой", "сна", "льда", "рта", "дня"}),
+	"под":   str.NewWordSet([]string{"мной", "мною", "всем", "всеми", "льдом", "что"}),
+	"над":   str.NewWordSet([]string{"мной", "мною", "всем", "всеми", "льдом"}),
+	"перед": str.NewWordSet([]string{"мной", "мною", "всем", "всеми"}),
+	"пред":  str.NewWordSet([]string{"мной", "мною", "всем", "всеми"}),
+	"без":   str.NewWordSet([]string{"всего", "всякого", "всякой", "всех"}),
+}
+
+/**
+ * Гласные, перед которыми предлог "о" становится "об": об окне, об уроке, об этом.
+ * Перед е, ё, ю, я используется "о": о ёлке, о юге.
+ * @var string[]
+ */
+var obVowels = str.NewWordSet([]string{"а", "и", "о", "у", "ы", "э"})
+
+/**
+ * Падежи, которыми управляют предлоги. Для многозначных предлогов первым указан
+ * падеж, используемый по умолчанию: в доме (но в дом), за столом (но за стол).
+ */
+var governedCases = map[string][]cases.Case{
+	"без": {cases.Rodit}, "для": {cases.Rodit}, "до": {cases.Rodit}, "из": {cases.Rodit},
+	"от": {cases.Rodit}, "у": {cases.Rodit}, "около": {cases.Rodit}, "возле": {cases.Rodit},
+	"после": {cases.Rodit}, "кроме": {cases.Rodit}, "среди": {cases.Rodit}, "вокруг": {cases.Rodit},
+	"из-за": {cases.Rodit}, "из-под": {cases.Rodit}, "вместо": {cases.Rodit}, "мимо": {cases.Rodit},
+	"против": {cases.Rodit}, "ради": {cases.Rodit}, "внутри": {cases.Rodit}, "вдоль": {cases.Rodit},
+
+	"к": {cases.Dat}, "благодаря": {cases.Dat}, "согласно": {cases.Dat}, "навстречу": {cases.Dat},
+	"вопреки": {cases.Dat},
+
+	"про": {cases.Vinit}, "через": {cases.Vinit}, "сквозь": {cases.Vinit},
+
+	"над": {cases.Tvorit}, "перед": {cases.Tvorit}, "между": {cases.Tvorit},
+
+	"при": {cases.Predloj},
+
+	"в":   {cases.Predloj, cases.Vinit},
+	"на":  {cases.Predloj, cases.Vinit},
+	"о":   {cases.Predloj, cases.Vinit},
+	"об":  {cases.Predloj, cases.Vinit},
+	"за":  {cases.Tvorit, cases.Vinit},
+	"под": {cases.Tvorit, cases.Vinit},
+	"с":   {cases.Tvorit, cases.Rodit},
+	"по":  {cases.Dat, cases.Vinit, cases.Predloj},
+}
@@ -0,0 +1,96 @@
+package preposition
+
+import (
+	"errors"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/russian/pronoun"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение падежей, которыми управляет предлог. Первым идет падеж по умолчанию.
+ * @param string $preposition
+ * @return string[]
+ */
+func GetCases(prep str.Word) []cases.Case {
+	return governedCases[normalize(prep)]
+}
+
+/**
+ * Проверка, управляет ли предлог указанным падежом.
+ * @param string $preposition
+ * @param string $case
+ * @return bool
+ */
+func Governs(prep str.Word, cCase cases.Case) bool {
+	for _, governed := range GetCases(prep) {
+		if governed == cCase {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Получение предложного сочетания с существительным в падеже, которым управляет предлог:
+ * для пользователя, к заказу, над столом, при оплате.
+ * Для многозначных предлогов (в, на, за, под, с, по, о) используется падеж по умолчанию.
+ * @param string $preposition
+ * @param string $noun
+ * @param bool $animateness
+ * @return string
+ * @throws \Exception
+ */
+func WithPreposition(prep, w str.Word, animateness bool) (string, error) {
+	governed := GetCases(prep)
+	if len(governed) == 0 {
+		return w.String(), errors.New("unknown preposition " + prep.String())
+	}
+	return WithPrepositionCase(prep, w, governed[0], animateness)
+}
+
+/**
+ * Получение предложного сочетания с существительным в указанном падеже: в дом, за стол, с горы.
+ * Личные местоимения третьего лица получают начальное н-: к нему, с ней.
+ * @param string $preposition
+ * @param string $noun
+ * @param string $case
+ * @param bool $animateness
+ * @return string
+ * @throws \Exception
+ */
+func WithPrepositionCase(prep, w str.Word, cCase cases.Case, animateness bool) (string, error) {
+	if !Governs(prep, cCase) {
+		return w.String(), errors.New("preposition " + prep.String() + " does not govern the case")
+	}
+
+	var form string
+	if pronoun.IsPersonal(w) {
+		forms, err := pronoun.GetCases(w, true)
+		if err != nil {
+			return w.String(), err
+		}
+		form = forms[cCase]
+	} else {
+		form = declension.GetCases(w, animateness)[cCase]
+	}
+
+	return GetVariant(prep, str.Word(form)) + " " + form, nil
+}
+
+/**
+ * Приведение предлога к табличной форме: Во - в, обо - о, изо - из.
+ * @param string $preposition
+ * @return string
+ */
+func normalize(prep str.Word) string {
+	lower := prep.Lower().String()
+	for short, long := range longForms {
+		if lower == long && short != "об" {
+			return short
+		}
+	}
+	return lower
+}
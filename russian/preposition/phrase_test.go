@@ -0,0 +1,61 @@
+package preposition
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WithPreposition(t *testing.T) {
+	tests := []struct {
+		Preposition string
+		Word        string
+		Animateness bool
+		Result      string
+	}{
+		{Preposition: "для", Word: "пользователь", Animateness: true, Result: "для пользователя"},
+		{Preposition: "к", Word: "заказ", Result: "к заказу"},
+		{Preposition: "про", Word: "кот", Animateness: true, Result: "про кота"},
+		{Preposition: "про", Word: "стол", Result: "про стол"},
+		{Preposition: "над", Word: "стол", Result: "над столом"},
+		{Preposition: "при", Word: "оплата", Result: "при оплате"},
+		{Preposition: "в", Word: "окно", Result: "в окне"},
+		{Preposition: "о", Word: "окно", Result: "об окне"},
+		{Preposition: "с", Word: "стол", Result: "со столом"},
+		{Preposition: "к", Word: "он", Result: "к нему"},
+		{Preposition: "с", Word: "я", Result: "со мной"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Preposition+" "+tst.Word, func(t *testing.T) {
+			result, err := WithPreposition(str.Word(tst.Preposition), str.Word(tst.Word), tst.Animateness)
+			require.NoError(t, err)
+			assert.Equal(t, tst.Result, result)
+		})
+	}
+
+	_, err := WithPreposition(str.Word("стол"), str.Word("стол"), false)
+	assert.Error(t, err)
+}
+
+func Test_WithPrepositionCase(t *testing.T) {
+	result, err := WithPrepositionCase(str.Word("в"), str.Word("дом"), cases.Vinit, false)
+	require.NoError(t, err)
+	assert.Equal(t, "в дом", result)
+
+	result, err = WithPrepositionCase(str.Word("с"), str.Word("стол"), cases.Rodit, false)
+	require.NoError(t, err)
+	assert.Equal(t, "со стола", result)
+
+	_, err = WithPrepositionCase(str.Word("для"), str.Word("стол"), cases.Dat, false)
+	assert.Error(t, err)
+}
+
+func Test_GetCases(t *testing.T) {
+	assert.Equal(t, []cases.Case{cases.Rodit}, GetCases(str.Word("для")))
+	assert.Equal(t, []cases.Case{cases.Predloj, cases.Vinit}, GetCases(str.Word("Во")))
+	assert.Empty(t, GetCases(str.Word("стол")))
+}
@@ -0,0 +1,75 @@
+package preposition
+
+import (
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Выбор формы предлога для стоящего после него слова (уже в нужном падеже):
+ * об окне, обо мне, во вторник, со стола, ко мне.
+ * Регистр первой буквы предлога сохраняется: Во вторник.
+ * @param string $preposition
+ * @param string $word Следующее слово
+ * @return string
+ */
+func GetVariant(prep, next str.Word) string {
+	lower := prep.Lower()
+	variant := getVariant(lower.String(), next.Lower())
+	if variant == lower.String() {
+		return prep.String()
+	}
+
+	if prep.Len() > 0 && prep.SliceWord(0, 1).Lower().String() != prep.Chars(0, 1) {
+		v := str.Word(variant)
+		return v.SliceWord(0, 1).Upper().String() + v.Chars(1, v.Len())
+	}
+	return variant
+}
+
+/**
+ * Объединение предлога в нужной форме со следующим словом или словосочетанием: об окне, со стола.
+ * @param string $preposition
+ * @param string $phrase
+ * @return string
+ */
+func Join(prep str.Word, phrase string) string {
+	next := phrase
+	if ind := strings.Index(phrase, " "); ind >= 0 {
+		next = phrase[:ind]
+	}
+	return GetVariant(prep, str.Word(next)) + " " + phrase
+}
+
+/**
+ * @param string $preposition В нижнем регистре
+ * @param string $word В нижнем регистре
+ * @return string
+ */
+func getVariant(prep string, next str.Word) string {
+	long, has := longForms[prep]
+	if !has || next.Len() == 0 {
+		return prep
+	}
+
+	if words, has := longFormWords[prep]; has && words.Has(next) {
+		return long
+	}
+
+	first := next.Chars(0, 1)
+	switch prep {
+	case "о", "об":
+		if obVowels.HasStr(first) {
+			return "об"
+		}
+		return "о"
+
+	case "в", "с":
+		if next.Len() > 1 && clusterStarts[prep].HasStr(first) && russian.IsConsonant(next.Chars(1, 2)) {
+			return long
+		}
+	}
+	return prep
+}
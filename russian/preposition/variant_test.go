@@ -0,0 +1,51 @@
+package preposition
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetVariant(t *testing.T) {
+	tests := []struct {
+		Preposition string
+		Word        string
+		Variant     string
+	}{
+		{Preposition: "о", Word: "окне", Variant: "об"},
+		{Preposition: "о", Word: "этом", Variant: "об"},
+		{Preposition: "о", Word: "мне", Variant: "обо"},
+		{Preposition: "о", Word: "ёлке", Variant: "о"},
+		{Preposition: "об", Word: "столе", Variant: "о"},
+		{Preposition: "в", Word: "вторник", Variant: "во"},
+		{Preposition: "в", Word: "Франции", Variant: "во"},
+		{Preposition: "в", Word: "Москве", Variant: "в"},
+		{Preposition: "в", Word: "воде", Variant: "в"},
+		{Preposition: "в", Word: "мне", Variant: "во"},
+		{Preposition: "с", Word: "стола", Variant: "со"},
+		{Preposition: "с", Word: "звуком", Variant: "со"},
+		{Preposition: "с", Word: "сестрой", Variant: "с"},
+		{Preposition: "с", Word: "мной", Variant: "со"},
+		{Preposition: "к", Word: "мне", Variant: "ко"},
+		{Preposition: "к", Word: "клиенту", Variant: "к"},
+		{Preposition: "к", Word: "Всем", Variant: "ко"},
+		{Preposition: "из", Word: "рта", Variant: "изо"},
+		{Preposition: "под", Word: "мной", Variant: "подо"},
+		{Preposition: "перед", Word: "мной", Variant: "передо"},
+		{Preposition: "на", Word: "мне", Variant: "на"},
+		{Preposition: "В", Word: "вторник", Variant: "Во"},
+		{Preposition: "С", Word: "сыром", Variant: "С"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Preposition+" "+tst.Word, func(t *testing.T) {
+			assert.Equal(t, tst.Variant, GetVariant(str.Word(tst.Preposition), str.Word(tst.Word)))
+		})
+	}
+}
+
+func Test_Join(t *testing.T) {
+	assert.Equal(t, "об одном заказе", Join(str.Word("о"), "одном заказе"))
+	assert.Equal(t, "со стола", Join(str.Word("с"), "стола"))
+}
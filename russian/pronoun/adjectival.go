@@ -0,0 +1,97 @@
+package pronoun
+
+import (
+	"errors"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/russian/adjective"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+const pluralIndex = 3
+
+/**
+ * Проверка, является ли слово притяжательным или указательным местоимением (в начальной форме).
+ * @param string $pronoun
+ * @return bool
+ */
+func IsAdjectival(w str.Word) bool {
+	w = w.Lower()
+	_, has := adjectival[w.String()]
+	return has || adjectiveLike.Has(w)
+}
+
+/**
+ * Получение всех падежей притяжательного (мой, твой, свой, наш, ваш) или указательного
+ * (этот, тот, весь, сам, какой, который) местоимения в единственном числе нужного рода.
+ * Местоимение передается в начальной форме (мужской род): в вашем заказе, моего друга.
+ * @param string $pronoun
+ * @param bool $animateness Признак одушевленности определяемого существительного
+ * @param string $gender Род определяемого существительного
+ * @return string[]
+ * @throws \Exception
+ */
+func GetAdjectivalCases(w str.Word, animateness bool, gendr gender.Gender) (cases.Cases, error) {
+	result, err := getAdjectivalGenderCases(w.Lower(), animateness, gendr)
+	return cases.ApplyCasePattern(w, result), err
+}
+
+func getAdjectivalGenderCases(w str.Word, animateness bool, gendr gender.Gender) (cases.Cases, error) {
+	if adjectiveLike.Has(w) {
+		return adjective.GetCases(w, animateness, gendr)
+	}
+
+	index := 0
+	switch gendr {
+	case gender.Female:
+		index = 1
+	case gender.Neuter:
+		index = 2
+	}
+	return getAdjectivalCases(w, index, animateness)
+}
+
+/**
+ * Получение всех падежей притяжательного или указательного местоимения во множественном числе:
+ * эти, этих, этим, ...
+ * @param string $pronoun
+ * @param bool $animateness
+ * @return string[]
+ * @throws \Exception
+ */
+func GetAdjectivalPluralCases(w str.Word, animateness bool) (cases.Cases, error) {
+	result, err := getAdjectivalPluralCases(w.Lower(), animateness)
+	return cases.ApplyCasePattern(w, result), err
+}
+
+func getAdjectivalPluralCases(w str.Word, animateness bool) (cases.Cases, error) {
+	if adjectiveLike.Has(w) {
+		return adjective.GetPluralCases(w, animateness)
+	}
+	return getAdjectivalCases(w, pluralIndex, animateness)
+}
+
+/**
+ * @param string $pronoun
+ * @param int $index Род или множественное число
+ * @param bool $animateness
+ * @return string[]
+ * @throws \Exception
+ */
+func getAdjectivalCases(w str.Word, index int, animateness bool) (cases.Cases, error) {
+	paradigms, has := adjectival[w.String()]
+	if !has {
+		return cases.NewCasesWord(w), errors.New("unknown pronoun " + w.String())
+	}
+
+	result := cases.NewCases()
+	for ind, cCase := range caseOrder {
+		result[cCase] = paradigms[index][ind]
+	}
+	if result[cases.Vinit] == "" {
+		result[cases.Vinit] = russian.GetVinitCaseByAnimateness(result, animateness)
+	}
+	return result, nil
+}
@@ -0,0 +1,133 @@
+package pronoun
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetAdjectivalCases(t *testing.T) {
+	tests := []struct {
+		Pronoun     string
+		Gender      gender.Gender
+		Animateness bool
+		Cases       cases.Cases
+	}{
+		{
+			Pronoun: "мой", Gender: gender.Male, Animateness: true,
+			Cases: cases.Cases{
+				cases.Imenit: "мой", cases.Rodit: "моего", cases.Dat: "моему",
+				cases.Vinit: "моего", cases.Tvorit: "моим", cases.Predloj: "моём",
+			},
+		},
+		{
+			Pronoun: "Ваш", Gender: gender.Male,
+			Cases: cases.Cases{
+				cases.Imenit: "Ваш", cases.Rodit: "Вашего", cases.Dat: "Вашему",
+				cases.Vinit: "Ваш", cases.Tvorit: "Вашим", cases.Predloj: "Вашем",
+			},
+		},
+		{
+			Pronoun: "Который", Gender: gender.Female,
+			Cases: cases.Cases{
+				cases.Imenit: "Которая", cases.Rodit: "Которой", cases.Dat: "Которой",
+				cases.Vinit: "Которую", cases.Tvorit: "Которой", cases.Predloj: "Которой",
+			},
+		},
+		{
+			Pronoun: "ваш", Gender: gender.Male,
+			Cases: cases.Cases{
+				cases.Imenit: "ваш", cases.Rodit: "вашего", cases.Dat: "вашему",
+				cases.Vinit: "ваш", cases.Tvorit: "вашим", cases.Predloj: "вашем",
+			},
+		},
+		{
+			Pronoun: "этот", Gender: gender.Female,
+			Cases: cases.Cases{
+				cases.Imenit: "эта", cases.Rodit: "этой", cases.Dat: "этой",
+				cases.Vinit: "эту", cases.Tvorit: "этой", cases.Predloj: "этой",
+			},
+		},
+		{
+			Pronoun: "весь", Gender: gender.Neuter, Animateness: true,
+			Cases: cases.Cases{
+				cases.Imenit: "всё", cases.Rodit: "всего", cases.Dat: "всему",
+				cases.Vinit: "всё", cases.Tvorit: "всем", cases.Predloj: "всём",
+			},
+		},
+		{
+			Pronoun: "который", Gender: gender.Female,
+			Cases: cases.Cases{
+				cases.Imenit: "которая", cases.Rodit: "которой", cases.Dat: "которой",
+				cases.Vinit: "которую", cases.Tvorit: "которой", cases.Predloj: "которой",
+			},
+		},
+		{
+			Pronoun: "какой", Gender: gender.Male, Animateness: true,
+			Cases: cases.Cases{
+				cases.Imenit: "какой", cases.Rodit: "какого", cases.Dat: "какому",
+				cases.Vinit: "какого", cases.Tvorit: "каким", cases.Predloj: "каком",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Pronoun, func(t *testing.T) {
+			forms, err := GetAdjectivalCases(str.Word(tst.Pronoun), tst.Animateness, tst.Gender)
+			require.NoError(t, err)
+			assert.Equal(t, tst.Cases, forms)
+		})
+	}
+
+	_, err := GetAdjectivalCases(str.Word("стол"), false, gender.Male)
+	assert.Error(t, err)
+}
+
+func Test_GetAdjectivalPluralCases(t *testing.T) {
+	tests := []struct {
+		Pronoun     string
+		Animateness bool
+		Cases       cases.Cases
+	}{
+		{
+			Pronoun: "тот", Animateness: true,
+			Cases: cases.Cases{
+				cases.Imenit: "те", cases.Rodit: "тех", cases.Dat: "тем",
+				cases.Vinit: "тех", cases.Tvorit: "теми", cases.Predloj: "тех",
+			},
+		},
+		{
+			Pronoun: "Ваш",
+			Cases: cases.Cases{
+				cases.Imenit: "Ваши", cases.Rodit: "Ваших", cases.Dat: "Вашим",
+				cases.Vinit: "Ваши", cases.Tvorit: "Вашими", cases.Predloj: "Ваших",
+			},
+		},
+		{
+			Pronoun: "весь",
+			Cases: cases.Cases{
+				cases.Imenit: "все", cases.Rodit: "всех", cases.Dat: "всем",
+				cases.Vinit: "все", cases.Tvorit: "всеми", cases.Predloj: "всех",
+			},
+		},
+		{
+			Pronoun: "который",
+			Cases: cases.Cases{
+				cases.Imenit: "которые", cases.Rodit: "которых", cases.Dat: "которым",
+				cases.Vinit: "которые", cases.Tvorit: "которыми", cases.Predloj: "которых",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Pronoun, func(t *testing.T) {
+			forms, err := GetAdjectivalPluralCases(str.Word(tst.Pronoun), tst.Animateness)
+			require.NoError(t, err)
+			assert.Equal(t, tst.Cases, forms)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package pronoun
+
+import "github.com/dshipenok/gomorphos/str"
+
+/**
+ * Формы личных и возвратного местоимений: именительный, родительный, дательный,
+ * винительный, творительный, предложный падежи.
+ * У "себя" нет именительного падежа.
+ */
+var personal = str.NewWordMap(map[string][]string{
+	"я":    {"я", "меня", "мне", "меня", "мной", "мне"},
+	"ты":   {"ты", "тебя", "тебе", "тебя", "тобой", "тебе"},
+	"он":   {"он", "его", "ему", "его", "им", "нём"},
+	"оно":  {"оно", "его", "ему", "его", "им", "нём"},
+	"она":  {"она", "её", "ей", "её", "ей", "ней"},
+	"мы":   {"мы", "нас", "нам", "нас", "нами", "нас"},
+	"вы":   {"вы", "вас", "вам", "вас", "вами", "вас"},
+	"они":  {"они", "их", "им", "их", "ими", "них"},
+	"себя": {"", "себя", "себе", "себя", "собой", "себе"},
+})
+
+/**
+ * Формы местоимений третьего лица после предлогов: к нему, с ней, о них.
+ */
+var prepositional = str.NewWordMap(map[string][]string{
+	"он":  {"он", "него", "нему", "него", "ним", "нём"},
+	"оно": {"оно", "него", "нему", "него", "ним", "нём"},
+	"она": {"она", "неё", "ней", "неё", "ней", "ней"},
+	"они": {"они", "них", "ним", "них", "ними", "них"},
+})
+
+/**
+ * Формы притяжательных и указательных местоимений: мужской, женский, средний род, множественное число.
+ * Винительный падеж мужского рода и множественного числа (пустая строка) зависит от одушевленности.
+ */
+var adjectival = map[string][][]string{
+	"мой": {
+		{"мой", "моего", "моему", "", "моим", "моём"},
+		{"моя", "моей", "моей", "мою", "моей", "моей"},
+		{"моё", "моего", "моему", "моё", "моим", "моём"},
+		{"мои", "моих", "моим", "", "моими", "моих"},
+	},
+	"твой": {
+		{"твой", "твоего", "твоему", "", "твоим", "твоём"},
+		{"твоя", "твоей", "твоей", "твою", "твоей", "твоей"},
+		{"твоё", "твоего", "твоему", "твоё", "твоим", "твоём"},
+		{"твои", "твоих", "твоим", "", "твоими", "твоих"},
+	},
+	"свой": {
+		{"свой", "своего", "своему", "", "своим", "своём"},
+		{"своя", "своей", "своей", "свою", "своей", "своей"},
+		{"своё", "своего", "своему", "своё", "своим", "своём"},
+		{"свои", "своих", "своим", "", "своими", "своих"},
+	},
+	"наш": {
+		{"наш", "нашего", "нашему", "", "нашим", "нашем"},
+		{"наша", "нашей", "нашей", "нашу", "нашей", "нашей"},
+		{"наше", "нашего", "нашему", "наше", "нашим", "нашем"},
+		{"наши", "наших", "нашим", "", "нашими", "наших"},
+	},
+	"ваш": {
+		{"ваш", "вашего", "вашему", "", "вашим", "вашем"},
+		{"ваша", "вашей", "вашей", "вашу", "вашей", "вашей"},
+		{"ваше", "вашего", "вашему", "ваше", "вашим", "вашем"},
+		{"ваши", "ваших", "вашим", "", "вашими", "ваших"},
+	},
+	"этот": {
+		{"этот", "этого", "этому", "", "этим", "этом"},
+		{"эта", "этой", "этой", "эту", "этой", "этой"},
+		{"это", "этого", "этому", "это", "этим", "этом"},
+		{"эти", "этих", "этим", "", "этими", "этих"},
+	},
+	"тот": {
+		{"тот", "того", "тому", "", "тем", "том"},
+		{"та", "той", "той", "ту", "той", "той"},
+		{"то", "того", "тому", "то", "тем", "том"},
+		{"те", "тех", "тем", "", "теми", "тех"},
+	},
+	"весь": {
+		{"весь", "всего", "всему", "", "всем", "всём"},
+		{"вся", "всей", "всей", "всю", "всей", "всей"},
+		{"всё", "всего", "всему", "всё", "всем", "всём"},
+		{"все", "всех", "всем", "", "всеми", "всех"},
+	},
+	"сам": {
+		{"сам", "самого", "самому", "", "самим", "самом"},
+		{"сама", "самой", "самой", "саму", "самой", "самой"},
+		{"само", "самого", "самому", "само", "самим", "самом"},
+		{"сами", "самих", "самим", "", "самими", "самих"},
+	},
+}
+
+/**
+ * Местоимения, склоняющиеся как прилагательные.
+ * @var string[]
+ */
+var adjectiveLike = str.NewWordSet([]string{
+	"какой", "который", "такой", "никакой", "некоторый", "каждый", "любой", "иной", "другой",
+})
@@ -0,0 +1,42 @@
+package pronoun
+
+import (
+	"strings"
+
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Порядок поиска начальных форм: для совпадающих форм (его - он, оно; им - он, они)
+ * выбирается первая.
+ * @var string[]
+ */
+var lemmaOrder = []string{
+	"я", "ты", "он", "она", "оно", "мы", "вы", "они", "себя",
+	"мой", "твой", "свой", "наш", "ваш", "этот", "тот", "весь", "сам",
+}
+
+/**
+ * Получение начальной формы местоимения по любой его форме: него - он, всех - весь, моему - мой.
+ * @param string $word
+ * @return string
+ * @return bool Признак того, что слово является формой местоимения
+ */
+func GetLemma(w str.Word) (string, bool) {
+	form := strings.Replace(w.Lower().String(), "ё", "е", -1)
+
+	for _, lemma := range lemmaOrder {
+		lemmaWord := str.Word(lemma)
+		paradigms := [][]string{personal.SliceOf(lemmaWord), prepositional.SliceOf(lemmaWord)}
+		paradigms = append(paradigms, adjectival[lemma]...)
+
+		for _, forms := range paradigms {
+			for _, f := range forms {
+				if f != "" && strings.Replace(f, "ё", "е", -1) == form {
+					return lemma, true
+				}
+			}
+		}
+	}
+	return w.Lower().String(), false
+}
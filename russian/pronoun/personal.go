@@ -0,0 +1,66 @@
+package pronoun
+
+import (
+	"errors"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+var caseOrder = []cases.Case{cases.Imenit, cases.Rodit, cases.Dat, cases.Vinit, cases.Tvorit, cases.Predloj}
+
+/**
+ * Проверка, является ли слово личным или возвратным местоимением.
+ * @param string $pronoun
+ * @return bool
+ */
+func IsPersonal(w str.Word) bool {
+	return personal.Has(w.Lower())
+}
+
+/**
+ * Получение всех падежей личного (я, ты, он, она, оно, мы, вы, они) или возвратного (себя) местоимения.
+ * После предлога местоимения третьего лица получают начальное н-: у него, к ней, с ними.
+ * @param string $pronoun
+ * @param bool $afterPreposition Местоимение стоит после предлога
+ * @return string[]
+ * @throws \Exception
+ */
+func GetCases(w str.Word, afterPreposition bool) (cases.Cases, error) {
+	result, err := getCases(w.Lower(), afterPreposition)
+	return cases.ApplyCasePattern(w, result), err
+}
+
+func getCases(w str.Word, afterPreposition bool) (cases.Cases, error) {
+	if !personal.Has(w) {
+		return cases.NewCasesWord(w), errors.New("unknown personal pronoun " + w.String())
+	}
+
+	forms := personal.SliceOf(w)
+	if afterPreposition && prepositional.Has(w) {
+		forms = prepositional.SliceOf(w)
+	}
+
+	result := cases.NewCases()
+	for ind, cCase := range caseOrder {
+		result[cCase] = forms[ind]
+	}
+	return result, nil
+}
+
+/**
+ * Получение одной формы личного местоимения (падежа).
+ * @param string $pronoun
+ * @param string $case
+ * @param bool $afterPreposition
+ * @return string
+ * @throws \Exception
+ */
+func GetCase(w str.Word, wCase string, afterPreposition bool) (string, error) {
+	cCase := cases.CanonizeCase(wCase)
+	forms, err := GetCases(w, afterPreposition)
+	if err != nil {
+		return w.String(), err
+	}
+	return forms[cCase], nil
+}
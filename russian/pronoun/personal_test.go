@@ -0,0 +1,118 @@
+package pronoun
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetCases(t *testing.T) {
+	tests := []struct {
+		Pronoun          string
+		AfterPreposition bool
+		Cases            cases.Cases
+	}{
+		{
+			Pronoun: "я",
+			Cases: cases.Cases{
+				cases.Imenit: "я", cases.Rodit: "меня", cases.Dat: "мне",
+				cases.Vinit: "меня", cases.Tvorit: "мной", cases.Predloj: "мне",
+			},
+		},
+		{
+			Pronoun: "она",
+			Cases: cases.Cases{
+				cases.Imenit: "она", cases.Rodit: "её", cases.Dat: "ей",
+				cases.Vinit: "её", cases.Tvorit: "ей", cases.Predloj: "ней",
+			},
+		},
+		{
+			Pronoun:          "она",
+			AfterPreposition: true,
+			Cases: cases.Cases{
+				cases.Imenit: "она", cases.Rodit: "неё", cases.Dat: "ней",
+				cases.Vinit: "неё", cases.Tvorit: "ней", cases.Predloj: "ней",
+			},
+		},
+		{
+			Pronoun:          "он",
+			AfterPreposition: true,
+			Cases: cases.Cases{
+				cases.Imenit: "он", cases.Rodit: "него", cases.Dat: "нему",
+				cases.Vinit: "него", cases.Tvorit: "ним", cases.Predloj: "нём",
+			},
+		},
+		{
+			Pronoun:          "Он",
+			AfterPreposition: true,
+			Cases: cases.Cases{
+				cases.Imenit: "Он", cases.Rodit: "Него", cases.Dat: "Нему",
+				cases.Vinit: "Него", cases.Tvorit: "Ним", cases.Predloj: "Нём",
+			},
+		},
+		{
+			Pronoun:          "мы",
+			AfterPreposition: true,
+			Cases: cases.Cases{
+				cases.Imenit: "мы", cases.Rodit: "нас", cases.Dat: "нам",
+				cases.Vinit: "нас", cases.Tvorit: "нами", cases.Predloj: "нас",
+			},
+		},
+		{
+			Pronoun: "себя",
+			Cases: cases.Cases{
+				cases.Imenit: "", cases.Rodit: "себя", cases.Dat: "себе",
+				cases.Vinit: "себя", cases.Tvorit: "собой", cases.Predloj: "себе",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Pronoun, func(t *testing.T) {
+			forms, err := GetCases(str.Word(tst.Pronoun), tst.AfterPreposition)
+			require.NoError(t, err)
+			assert.Equal(t, tst.Cases, forms)
+		})
+	}
+
+	_, err := GetCases(str.Word("стол"), false)
+	assert.Error(t, err)
+}
+
+func Test_GetCase(t *testing.T) {
+	form, err := GetCase(str.Word("они"), "творительный", true)
+	require.NoError(t, err)
+	assert.Equal(t, "ними", form)
+
+	form, err = GetCase(str.Word("они"), "творительный", false)
+	require.NoError(t, err)
+	assert.Equal(t, "ими", form)
+}
+
+func Test_GetLemma(t *testing.T) {
+	tests := map[string]string{
+		"него":  "он",
+		"ней":   "она",
+		"Её":    "она",
+		"ими":   "они",
+		"мной":  "я",
+		"собой": "себя",
+		"всех":  "весь",
+		"вашем": "ваш",
+		"те":    "тот",
+	}
+
+	for form, lemma := range tests {
+		t.Run(form, func(t *testing.T) {
+			result, has := GetLemma(str.Word(form))
+			assert.True(t, has)
+			assert.Equal(t, lemma, result)
+		})
+	}
+
+	_, has := GetLemma(str.Word("стол"))
+	assert.False(t, has)
+}
@@ -0,0 +1,54 @@
+package stem
+
+/**
+ * Группы окончаний алгоритма Snowball. Окончания первой группы отбрасываются,
+ * только если перед ними стоит а или я (сама гласная остается в основе).
+ */
+type endingGroups struct {
+	afterAYa []string
+	other    []string
+}
+
+var perfectiveGerund = endingGroups{
+	afterAYa: []string{"в", "вши", "вшись"},
+	other:    []string{"ив", "ивши", "ившись", "ыв", "ывши", "ывшись"},
+}
+
+var adjective = endingGroups{
+	other: []string{
+		"ее", "ие", "ые", "ое", "ими", "ыми", "ей", "ий", "ый", "ой", "ем", "им", "ым", "ом",
+		"его", "ого", "ему", "ому", "их", "ых", "ую", "юю", "ая", "яя", "ою", "ею",
+	},
+}
+
+var participle = endingGroups{
+	afterAYa: []string{"ем", "нн", "вш", "ющ", "щ"},
+	other:    []string{"ивш", "ывш", "ующ"},
+}
+
+var reflexive = endingGroups{
+	other: []string{"ся", "сь"},
+}
+
+var verb = endingGroups{
+	afterAYa: []string{"ла", "на", "ете", "йте", "ли", "й", "л", "ем", "н", "ло", "но", "ет", "ют", "ны", "ть", "ешь", "нно"},
+	other: []string{
+		"ила", "ыла", "ена", "ейте", "уйте", "ите", "или", "ыли", "ей", "уй", "ил", "ыл", "им", "ым", "ен",
+		"ило", "ыло", "ено", "ят", "ует", "уют", "ит", "ыт", "ены", "ить", "ыть", "ишь", "ую", "ю",
+	},
+}
+
+var noun = endingGroups{
+	other: []string{
+		"а", "ев", "ов", "ие", "ье", "е", "иями", "ями", "ами", "еи", "ии", "и", "ией", "ей", "ой", "ий", "й",
+		"иям", "ям", "ием", "ем", "ам", "ом", "о", "у", "ах", "иях", "ях", "ы", "ь", "ию", "ью", "ю", "ия", "ья", "я",
+	},
+}
+
+var derivational = endingGroups{
+	other: []string{"ост", "ость"},
+}
+
+var superlative = endingGroups{
+	other: []string{"ейш", "ейше"},
+}
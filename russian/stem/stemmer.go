@@ -0,0 +1,173 @@
+package stem
+
+import (
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian"
+	declension "github.com/dshipenok/gomorphos/russian/noun"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Стеммер для русского языка по алгоритму Snowball (http://snowball.tartarus.org/algorithms/russian/stemmer.html).
+ * При UseExceptions несклоняемые слова не усекаются (пальто, такси), а формы слов с нестандартным
+ * склонением приводятся к основе начальной формы (пути, путём - пут).
+ */
+type Stemmer struct {
+	UseExceptions bool
+}
+
+/**
+ * Получение основы слова: важнейшими - важн, вагонами - вагон.
+ * @param string $word
+ * @return string
+ */
+func (s Stemmer) Stem(w str.Word) string {
+	w = str.Word(strings.Replace(w.Lower().String(), "ё", "е", -1))
+
+	if s.UseExceptions {
+		if declension.IsImmutable(w) {
+			return w.String()
+		}
+		if lemma, has := declension.GetAbnormalLemma(w); has {
+			w = str.Word(lemma)
+		}
+	}
+
+	return stem(w)
+}
+
+/**
+ * Получение основы слова по алгоритму Snowball без учета исключений.
+ * @param string $word
+ * @return string
+ */
+func Stem(w str.Word) string {
+	return Stemmer{}.Stem(w)
+}
+
+/**
+ * @param string $word В нижнем регистре, без ё
+ * @return string
+ */
+func stem(w str.Word) string {
+	rv, r2 := getRegions(w)
+	if rv >= w.Len() {
+		return w.String()
+	}
+
+	// Шаг 1
+	if result, has := removeEnding(w, rv, perfectiveGerund); has {
+		w = result
+	} else {
+		if result, has := removeEnding(w, rv, reflexive); has {
+			w = result
+		}
+
+		if result, has := removeEnding(w, rv, adjective); has {
+			w = result
+			if result, has := removeEnding(w, rv, participle); has {
+				w = result
+			}
+		} else if result, has := removeEnding(w, rv, verb); has {
+			w = result
+		} else if result, has := removeEnding(w, rv, noun); has {
+			w = result
+		}
+	}
+
+	// Шаг 2
+	if w.Len() > rv && w.EndsWith(1, "и") {
+		w = w.SliceWord(0, -1)
+	}
+
+	// Шаг 3
+	if result, has := removeEnding(w, r2, derivational); has {
+		w = result
+	}
+
+	// Шаг 4
+	switch {
+	case w.Len()-2 >= rv && w.EndsWith(2, "нн"):
+		w = w.SliceWord(0, -1)
+	case w.Len() > rv && w.EndsWith(1, "ь"):
+		w = w.SliceWord(0, -1)
+	default:
+		if result, has := removeEnding(w, rv, superlative); has {
+			w = result
+			if w.Len()-2 >= rv && w.EndsWith(2, "нн") {
+				w = w.SliceWord(0, -1)
+			}
+		}
+	}
+
+	return w.String()
+}
+
+/**
+ * Области слова RV (после первой гласной) и R2 (R1 внутри R1, где R1 - после первой согласной,
+ * следующей за гласной).
+ * @param string $word
+ * @return int[]
+ */
+func getRegions(w str.Word) (rv, r2 int) {
+	rv, r1 := w.Len(), w.Len()
+	for i := 0; i < w.Len(); i++ {
+		if russian.IsVowel(w.Chars(i, i+1)) {
+			rv = i + 1
+			break
+		}
+	}
+	r1 = findRegion(w, 0)
+	r2 = findRegion(w, r1)
+	return rv, r2
+}
+
+/**
+ * Начало области после первой согласной, следующей за гласной, начиная с позиции from.
+ * @param string $word
+ * @param int $from
+ * @return int
+ */
+func findRegion(w str.Word, from int) int {
+	for i := from + 1; i < w.Len(); i++ {
+		if !russian.IsVowel(w.Chars(i, i+1)) && russian.IsVowel(w.Chars(i-1, i)) {
+			return i + 1
+		}
+	}
+	return w.Len()
+}
+
+/**
+ * Отбрасывание самого длинного окончания из группы, лежащего в области, начинающейся с позиции from.
+ * Если для самого длинного окончания не выполнено условие (а/я перед ним), окончание не отбрасывается.
+ * @param string $word
+ * @param int $from
+ * @param array $groups
+ * @return string
+ * @return bool
+ */
+func removeEnding(w str.Word, from int, groups endingGroups) (str.Word, bool) {
+	longest, afterAYa := "", false
+	for _, group := range []struct {
+		endings  []string
+		afterAYa bool
+	}{{groups.afterAYa, true}, {groups.other, false}} {
+		for _, ending := range group.endings {
+			length := len([]rune(ending))
+			if length > len([]rune(longest)) && w.Len()-length >= from && w.EndsWith(length, ending) {
+				longest, afterAYa = ending, group.afterAYa
+			}
+		}
+	}
+
+	if longest == "" {
+		return w, false
+	}
+
+	start := w.Len() - len([]rune(longest))
+	if afterAYa && (start-1 < from || !w.SliceWord(start-1, start).OneOf("а", "я")) {
+		return w, false
+	}
+	return w.SliceWord(0, start), true
+}
@@ -0,0 +1,57 @@
+package stem
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Stem(t *testing.T) {
+	tests := map[string]string{
+		"в":          "в",
+		"вавиловка":  "вавиловк",
+		"вагона":     "вагон",
+		"вагонов":    "вагон",
+		"вагоны":     "вагон",
+		"важная":     "важн",
+		"важнейшими": "важн",
+		"важничаешь": "важнича",
+		"важного":    "важн",
+		"вазах":      "ваз",
+		"вакансия":   "ваканс",
+		"вакансий":   "ваканс",
+		"бегущий":    "бегущ",
+		"сделавшись": "сдела",
+		"новости":    "новост",
+		"собирались": "собира",
+		"Путём":      "пут",
+		"такси":      "такс",
+		"времени":    "времен",
+	}
+
+	for word, expected := range tests {
+		t.Run(word, func(t *testing.T) {
+			assert.Equal(t, expected, Stem(str.Word(word)))
+		})
+	}
+}
+
+func Test_Stemmer_UseExceptions(t *testing.T) {
+	stemmer := Stemmer{UseExceptions: true}
+
+	tests := map[string]string{
+		"такси":   "такси",
+		"пальто":  "пальто",
+		"пути":    "пут",
+		"времени": "врем",
+		"время":   "врем",
+		"вагонов": "вагон",
+	}
+
+	for word, expected := range tests {
+		t.Run(word, func(t *testing.T) {
+			assert.Equal(t, expected, stemmer.Stem(str.Word(word)))
+		})
+	}
+}
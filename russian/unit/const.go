@@ -0,0 +1,54 @@
+package unit
+
+/**
+ * Единицы измерения: сокращение и полное название в именительном падеже единственного числа.
+ */
+var units = []struct {
+	Short string
+	Full  string
+}{
+	// длина
+	{"мм", "миллиметр"},
+	{"см", "сантиметр"},
+	{"дм", "дециметр"},
+	{"м", "метр"},
+	{"км", "километр"},
+	// площадь и объем
+	{"м²", "квадратный метр"},
+	{"км²", "квадратный километр"},
+	{"га", "гектар"},
+	{"м³", "кубический метр"},
+	{"мл", "миллилитр"},
+	{"л", "литр"},
+	// масса
+	{"мг", "миллиграмм"},
+	{"г", "грамм"},
+	{"кг", "килограмм"},
+	{"ц", "центнер"},
+	{"т", "тонна"},
+	// время
+	{"мс", "миллисекунда"},
+	{"с", "секунда"},
+	{"мин", "минута"},
+	{"ч", "час"},
+	{"сут.", "сутки"},
+	// электричество и частота
+	{"Вт", "ватт"},
+	{"кВт", "киловатт"},
+	{"В", "вольт"},
+	{"А", "ампер"},
+	{"Гц", "герц"},
+	// информация
+	{"Б", "байт"},
+	{"КБ", "килобайт"},
+	{"МБ", "мегабайт"},
+	{"ГБ", "гигабайт"},
+	{"ТБ", "терабайт"},
+	// торговые
+	{"шт.", "штука"},
+	{"уп.", "упаковка"},
+	{"пар.", "пара"},
+	{"кор.", "коробка"},
+	{"руб.", "рубль"},
+	{"коп.", "копейка"},
+}
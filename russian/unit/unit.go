@@ -0,0 +1,120 @@
+package unit
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/russian/phrase"
+)
+
+/**
+ * Запись целого количества с единицей измерения: 5 км, 1 километр, 100 штук.
+ * @param int $count
+ * @param string $unit Сокращение (км, шт.) или полное название (километр, штука)
+ * @param bool $full Полное название вместо сокращения
+ * @return string
+ * @throws \Exception
+ */
+func Format(count int64, unit string, full bool) (string, error) {
+	short, fullName, err := findUnit(unit)
+	if err != nil {
+		return strconv.FormatInt(count, 10) + " " + unit, err
+	}
+
+	result := strconv.FormatInt(count, 10) + " "
+	if !full {
+		return result + short, nil
+	}
+
+	form, err := phrase.Pluralize(count, fullName, false, cases.Imenit)
+	if err != nil {
+		return result + unit, err
+	}
+	return result + form, nil
+}
+
+/**
+ * Запись дробного количества с единицей измерения: 2,5 кг, 2,5 килограмма.
+ * После дроби существительное стоит в родительном падеже единственного числа.
+ * Целое количество записывается так же, как в Format.
+ * @param string $number Число с разделителем "," или "."
+ * @param string $unit Сокращение (кг) или полное название (килограмм)
+ * @param bool $full Полное название вместо сокращения
+ * @return string
+ * @throws \Exception
+ */
+func FormatDecimal(value string, unit string, full bool) (string, error) {
+	value = strings.TrimSpace(value)
+	if !strings.ContainsAny(value, ".,") {
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return value + " " + unit, errors.New("invalid number " + value)
+		}
+		return Format(count, unit, full)
+	}
+
+	if !isDecimal(value) {
+		return value + " " + unit, errors.New("invalid number " + value)
+	}
+	result := strings.Replace(value, ".", ",", 1) + " "
+
+	short, fullName, err := findUnit(unit)
+	if err != nil {
+		return result + unit, err
+	}
+	if !full {
+		return result + short, nil
+	}
+
+	forms, err := phrase.GetCases(fullName, false, number.Singular)
+	if err != nil {
+		return result + unit, err
+	}
+	return result + forms[cases.Rodit], nil
+}
+
+/**
+ * Проверка записи дробного числа: цифры с обеих сторон от разделителя, знак минус допускается
+ * (2,5, -0.75, но не ,5 и 2.).
+ * @param string $number
+ * @return bool
+ */
+func isDecimal(value string) bool {
+	parts := strings.Split(strings.Replace(strings.TrimPrefix(value, "-"), ",", ".", 1), ".")
+	return len(parts) == 2 && isDigits(parts[0]) && isDigits(parts[1])
+}
+
+/**
+ * @param string $string
+ * @return bool
+ */
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+ * Поиск единицы измерения по сокращению (с точкой на конце или без нее) или полному названию.
+ * @param string $unit
+ * @return string Сокращение
+ * @return string Полное название
+ * @throws \Exception
+ */
+func findUnit(unit string) (string, string, error) {
+	for _, u := range units {
+		if u.Short == unit || strings.TrimSuffix(u.Short, ".") == unit || u.Full == strings.ToLower(unit) {
+			return u.Short, u.Full, nil
+		}
+	}
+	return unit, unit, errors.New("unknown unit " + unit)
+}
@@ -0,0 +1,67 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Format(t *testing.T) {
+	tests := []struct {
+		Count  int64
+		Unit   string
+		Full   bool
+		Result string
+	}{
+		{Count: 5, Unit: "км", Result: "5 км"},
+		{Count: 1, Unit: "км", Full: true, Result: "1 километр"},
+		{Count: 5, Unit: "км", Full: true, Result: "5 километров"},
+		{Count: 100, Unit: "шт", Full: true, Result: "100 штук"},
+		{Count: 100, Unit: "штука", Result: "100 шт."},
+		{Count: 22, Unit: "т", Full: true, Result: "22 тонны"},
+		{Count: 1000000, Unit: "г", Full: true, Result: "1000000 граммов"},
+		{Count: 220, Unit: "В", Full: true, Result: "220 вольт"},
+		{Count: 3, Unit: "м²", Full: true, Result: "3 квадратных метра"},
+		{Count: 2, Unit: "сут.", Full: true, Result: "2 суток"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Result, func(t *testing.T) {
+			result, err := Format(tst.Count, tst.Unit, tst.Full)
+			require.NoError(t, err)
+			assert.Equal(t, tst.Result, result)
+		})
+	}
+
+	_, err := Format(1, "попугай", true)
+	assert.Error(t, err)
+}
+
+func Test_FormatDecimal(t *testing.T) {
+	tests := []struct {
+		Value  string
+		Unit   string
+		Full   bool
+		Result string
+	}{
+		{Value: "2,5", Unit: "кг", Full: true, Result: "2,5 килограмма"},
+		{Value: "2.5", Unit: "кг", Result: "2,5 кг"},
+		{Value: "0,75", Unit: "л", Full: true, Result: "0,75 литра"},
+		{Value: "1,5", Unit: "м²", Full: true, Result: "1,5 квадратного метра"},
+		{Value: "3", Unit: "минута", Full: true, Result: "3 минуты"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Result, func(t *testing.T) {
+			result, err := FormatDecimal(tst.Value, tst.Unit, tst.Full)
+			require.NoError(t, err)
+			assert.Equal(t, tst.Result, result)
+		})
+	}
+
+	for _, value := range []string{"2,x", ".5", "2.", ",", "1.5e3", "2,5,1"} {
+		_, err := FormatDecimal(value, "кг", true)
+		assert.Error(t, err, value)
+	}
+}
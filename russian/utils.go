@@ -139,7 +139,8 @@ func IsVelarConsonant(consonant string) bool {
  */
 func IsAdjectiveNoun(noun str.Word) bool {
 	return noun.EndsWith(2, "ой", "ий", "ый", "ая", "ое", "ее") &&
-		!noun.OneOf("гений", "комментарий")
+		!noun.OneOf("гений", "комментарий", "герой", "бой", "слой", "строй", "покой", "сбой", "конвой", "рой") &&
+		!noun.EndsWith(4, "арий", "орий", "ерий")
 }
 
 func GetVinitCaseByAnimateness(forms map[cases.Case]string, animate bool) string {
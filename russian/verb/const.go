@@ -0,0 +1,372 @@
+package verb
+
+import "github.com/dshipenok/gomorphos/str"
+
+const (
+	FirstConjugation  = 1
+	SecondConjugation = 2
+)
+
+type Person int
+
+const (
+	First  Person = 1 // я, мы
+	Second Person = 2 // ты, вы
+	Third  Person = 3 // он, она, оно, они
+)
+
+/**
+ * Глагольные приставки, с которыми ищутся исключения: прийти = при + йти, помочь = по + мочь.
+ * @var string[]
+ */
+var prefixes = str.NewWordSet([]string{
+	"в", "во", "въ", "вз", "взо", "взъ", "вс", "вы",
+	"до", "за", "из", "изо", "изъ", "ис",
+	"на", "над", "надо", "недо",
+	"о", "об", "обо", "объ", "от", "ото", "отъ",
+	"пере", "по", "под", "подо", "подъ", "пре", "пред", "при", "про",
+	"раз", "разо", "разъ", "рас",
+	"воз", "вос", "соз",
+	"с", "со", "съ", "у",
+})
+
+/**
+ * Приставки с беглой -о-, которые пишутся перед стечением согласных: собрать, разорвать, сотру, разобью.
+ * Ключ - приставка с -о-, значение - приставка без нее.
+ */
+var fleetingVowelPrefixes = str.NewWordMap(map[string][]string{
+	"во":   {"в"},
+	"взо":  {"вз"},
+	"изо":  {"из"},
+	"обо":  {"об"},
+	"ото":  {"от"},
+	"подо": {"под"},
+	"надо": {"над"},
+	"разо": {"раз"},
+	"со":   {"с"},
+})
+
+/**
+ * Прошедшее время глаголов с нестандартной основой.
+ * Первый элемент - форма мужского рода, второй - основа остальных форм (пришёл - пришла).
+ */
+var pastExceptions = str.NewWordMap(map[string][]string{
+	"идти":   {"шёл", "шл"},
+	"йти":    {"шёл", "шл"},
+	"нести":  {"нёс", "несл"},
+	"везти":  {"вёз", "везл"},
+	"вести":  {"вёл", "вел"},
+	"мести":  {"мёл", "мел"},
+	"брести": {"брёл", "брел"},
+	"плести": {"плёл", "плел"},
+	"цвести": {"цвёл", "цвел"},
+	"грести": {"грёб", "гребл"},
+	"расти":  {"рос", "росл"},
+	"трясти": {"тряс", "трясл"},
+	"пасти":  {"пас", "пасл"},
+	"ползти": {"полз", "ползл"},
+	"лезть":  {"лез", "лезл"},
+
+	"мочь":    {"мог", "могл"},
+	"печь":    {"пёк", "пекл"},
+	"беречь":  {"берёг", "берегл"},
+	"стеречь": {"стерёг", "стерегл"},
+	"лечь":    {"лёг", "легл"},
+	"жечь":    {"жёг", "жгл"},
+	"течь":    {"тёк", "текл"},
+	"влечь":   {"влёк", "влекл"},
+	"сечь":    {"сёк", "секл"},
+	"стричь":  {"стриг", "стригл"},
+	"волочь":  {"волок", "волокл"},
+
+	"тереть": {"тёр", "тёрл"},
+	"мереть": {"мер", "мерл"},
+	"переть": {"пер", "перл"},
+
+	"есть":   {"ел", "ел"},
+	"сесть":  {"сел", "сел"},
+	"класть": {"клал", "клал"},
+	"красть": {"крал", "крал"},
+	"пасть":  {"пал", "пал"},
+	"клясть": {"клял", "клял"},
+	"шибить": {"шиб", "шибл"},
+
+	"чезнуть":  {"чез", "чезл"},
+	"сохнуть":  {"сох", "сохл"},
+	"мёрзнуть": {"мёрз", "мёрзл"},
+	"мерзнуть": {"мерз", "мерзл"},
+	"стигнуть": {"стиг", "стигл"},
+	"гибнуть":  {"гиб", "гибл"},
+	"выкнуть":  {"вык", "выкл"},
+	"мокнуть":  {"мок", "мокл"},
+	"глохнуть": {"глох", "глохл"},
+	"киснуть":  {"кис", "кисл"},
+})
+
+/**
+ * Глаголы, спрягаемые не по правилам.
+ * Порядок: я, ты, он, мы, вы, они.
+ */
+var presentExceptions = str.NewWordMap(map[string][]string{
+	"хотеть": {"хочу", "хочешь", "хочет", "хотим", "хотите", "хотят"},
+	"есть":   {"ем", "ешь", "ест", "едим", "едите", "едят"},
+	"дать":   {"дам", "дашь", "даст", "дадим", "дадите", "дадут"},
+	"бежать": {"бегу", "бежишь", "бежит", "бежим", "бежите", "бегут"},
+	"быть":   {"буду", "будешь", "будет", "будем", "будете", "будут"},
+	"идти":   {"иду", "идёшь", "идёт", "идём", "идёте", "идут"},
+	"йти":    {"йду", "йдёшь", "йдёт", "йдём", "йдёте", "йдут"},
+	"прийти": {"приду", "придёшь", "придёт", "придём", "придёте", "придут"},
+	"ехать":  {"еду", "едешь", "едет", "едем", "едете", "едут"},
+	"мочь":   {"могу", "можешь", "может", "можем", "можете", "могут"},
+	"жить":   {"живу", "живёшь", "живёт", "живём", "живёте", "живут"},
+	"плыть":  {"плыву", "плывёшь", "плывёт", "плывём", "плывёте", "плывут"},
+	"брать":  {"беру", "берёшь", "берёт", "берём", "берёте", "берут"},
+	"звать":  {"зову", "зовёшь", "зовёт", "зовём", "зовёте", "зовут"},
+	"ждать":  {"жду", "ждёшь", "ждёт", "ждём", "ждёте", "ждут"},
+	"рвать":  {"рву", "рвёшь", "рвёт", "рвём", "рвёте", "рвут"},
+	"врать":  {"вру", "врёшь", "врёт", "врём", "врёте", "врут"},
+	"лгать":  {"лгу", "лжёшь", "лжёт", "лжём", "лжёте", "лгут"},
+	"жать":   {"жму", "жмёшь", "жмёт", "жмём", "жмёте", "жмут"},
+	"пить":   {"пью", "пьёшь", "пьёт", "пьём", "пьёте", "пьют"},
+	"бить":   {"бью", "бьёшь", "бьёт", "бьём", "бьёте", "бьют"},
+	"лить":   {"лью", "льёшь", "льёт", "льём", "льёте", "льют"},
+	"шить":   {"шью", "шьёшь", "шьёт", "шьём", "шьёте", "шьют"},
+	"вить":   {"вью", "вьёшь", "вьёт", "вьём", "вьёте", "вьют"},
+	"брить":  {"брею", "бреешь", "бреет", "бреем", "бреете", "бреют"},
+	"петь":   {"пою", "поёшь", "поёт", "поём", "поёте", "поют"},
+	"спать":  {"сплю", "спишь", "спит", "спим", "спите", "спят"},
+	"стать":  {"стану", "станешь", "станет", "станем", "станете", "станут"},
+	"деть":   {"дену", "денешь", "денет", "денем", "денете", "денут"},
+	"лечь":   {"лягу", "ляжешь", "ляжет", "ляжем", "ляжете", "лягут"},
+	"сесть":  {"сяду", "сядешь", "сядет", "сядем", "сядете", "сядут"},
+	"гнать":  {"гоню", "гонишь", "гонит", "гоним", "гоните", "гонят"},
+
+	"нести":  {"несу", "несёшь", "несёт", "несём", "несёте", "несут"},
+	"везти":  {"везу", "везёшь", "везёт", "везём", "везёте", "везут"},
+	"вести":  {"веду", "ведёшь", "ведёт", "ведём", "ведёте", "ведут"},
+	"мести":  {"мету", "метёшь", "метёт", "метём", "метёте", "метут"},
+	"брести": {"бреду", "бредёшь", "бредёт", "бредём", "бредёте", "бредут"},
+	"плести": {"плету", "плетёшь", "плетёт", "плетём", "плетёте", "плетут"},
+	"цвести": {"цвету", "цветёшь", "цветёт", "цветём", "цветёте", "цветут"},
+	"грести": {"гребу", "гребёшь", "гребёт", "гребём", "гребёте", "гребут"},
+	"расти":  {"расту", "растёшь", "растёт", "растём", "растёте", "растут"},
+	"трясти": {"трясу", "трясёшь", "трясёт", "трясём", "трясёте", "трясут"},
+	"пасти":  {"пасу", "пасёшь", "пасёт", "пасём", "пасёте", "пасут"},
+	"ползти": {"ползу", "ползёшь", "ползёт", "ползём", "ползёте", "ползут"},
+	"лезть":  {"лезу", "лезешь", "лезет", "лезем", "лезете", "лезут"},
+
+	"печь":   {"пеку", "печёшь", "печёт", "печём", "печёте", "пекут"},
+	"беречь": {"берегу", "бережёшь", "бережёт", "бережём", "бережёте", "берегут"},
+	"жечь":   {"жгу", "жжёшь", "жжёт", "жжём", "жжёте", "жгут"},
+	"течь":   {"теку", "течёшь", "течёт", "течём", "течёте", "текут"},
+	"влечь":  {"влеку", "влечёшь", "влечёт", "влечём", "влечёте", "влекут"},
+	"стричь": {"стригу", "стрижёшь", "стрижёт", "стрижём", "стрижёте", "стригут"},
+
+	"тереть": {"тру", "трёшь", "трёт", "трём", "трёте", "трут"},
+	"мереть": {"мру", "мрёшь", "мрёт", "мрём", "мрёте", "мрут"},
+	"переть": {"пру", "прёшь", "прёт", "прём", "прёте", "прут"},
+	"шибить": {"шибу", "шибёшь", "шибёт", "шибём", "шибёте", "шибут"},
+
+	"класть":  {"кладу", "кладёшь", "кладёт", "кладём", "кладёте", "кладут"},
+	"красть":  {"краду", "крадёшь", "крадёт", "крадём", "крадёте", "крадут"},
+	"пасть":   {"паду", "падёшь", "падёт", "падём", "падёте", "падут"},
+	"клясть":  {"кляну", "клянёшь", "клянёт", "клянём", "клянёте", "клянут"},
+	"стеречь": {"стерегу", "стережёшь", "стережёт", "стережём", "стережёте", "стерегут"},
+	"сечь":    {"секу", "сечёшь", "сечёт", "сечём", "сечёте", "секут"},
+	"волочь":  {"волоку", "волочёшь", "волочёт", "волочём", "волочёте", "волокут"},
+
+	"взять":   {"возьму", "возьмёшь", "возьмёт", "возьмём", "возьмёте", "возьмут"},
+	"понять":  {"пойму", "поймёшь", "поймёт", "поймём", "поймёте", "поймут"},
+	"принять": {"приму", "примешь", "примет", "примем", "примете", "примут"},
+	"снять":   {"сниму", "снимешь", "снимет", "снимем", "снимете", "снимут"},
+	"поднять": {"подниму", "поднимешь", "поднимет", "поднимем", "поднимете", "поднимут"},
+	"отнять":  {"отниму", "отнимешь", "отнимет", "отнимем", "отнимете", "отнимут"},
+	"обнять":  {"обниму", "обнимешь", "обнимет", "обнимем", "обнимете", "обнимут"},
+	"занять":  {"займу", "займёшь", "займёт", "займём", "займёте", "займут"},
+	"нанять":  {"найму", "наймёшь", "наймёт", "наймём", "наймёте", "наймут"},
+	"начать":  {"начну", "начнёшь", "начнёт", "начнём", "начнёте", "начнут"},
+})
+
+/**
+ * Глаголы первого спряжения с чередованием согласной во всех формах: писать - пишу - пишешь.
+ * Значение - основа настоящего (простого будущего) времени.
+ */
+var presentStems = str.NewWordMap(map[string][]string{
+	"писать":    {"пиш"},
+	"казать":    {"каж"},
+	"сказать":   {"скаж"},
+	"резать":    {"реж"},
+	"мазать":    {"маж"},
+	"вязать":    {"вяж"},
+	"лизать":    {"лиж"},
+	"плакать":   {"плач"},
+	"скакать":   {"скач"},
+	"прятать":   {"пряч"},
+	"искать":    {"ищ"},
+	"махать":    {"маш"},
+	"пахать":    {"паш"},
+	"чесать":    {"чеш"},
+	"шептать":   {"шепч"},
+	"хохотать":  {"хохоч"},
+	"топтать":   {"топч"},
+	"щекотать":  {"щекоч"},
+	"клеветать": {"клевещ"},
+	"полоскать": {"полощ"},
+	"трепать":   {"трепл"},
+	"сыпать":    {"сыпл"},
+	"глодать":   {"глож"},
+})
+
+/**
+ * Глаголы второго спряжения на -еть, -ать, -ять (кроме них ко второму спряжению относятся глаголы на -ить).
+ * @var string[]
+ */
+var secondConjugationExceptions = str.NewWordSet([]string{
+	"смотреть", "видеть", "ненавидеть", "обидеть", "вертеть", "зависеть", "терпеть",
+	"сидеть", "висеть", "лететь", "шуметь", "храпеть", "кипеть", "гореть", "глядеть",
+	"греметь", "звенеть", "блестеть", "свистеть", "скрипеть", "сопеть", "хрипеть", "пыхтеть", "велеть",
+	"держать", "дышать", "слышать", "лежать", "кричать", "молчать", "стучать", "звучать", "мчать",
+	"дрожать", "визжать", "пищать", "рычать", "торчать", "ворчать", "трещать", "журчать",
+	"стоять", "боять",
+})
+
+/**
+ * Глаголы первого спряжения на -ить (кроме перечисленных в таблице исключений).
+ * @var string[]
+ */
+var firstConjugationExceptions = str.NewWordSet([]string{
+	"стелить",
+})
+
+/**
+ * Глаголы на -авать, у которых -ва- сохраняется: плавать - плаваю.
+ * @var string[]
+ */
+var keepVaVerbs = str.NewWordSet([]string{
+	"плавать",
+})
+
+/**
+ * Повелительное наклонение глаголов с нестандартной основой (форма на "ты").
+ * @var string[]
+ */
+var imperativeExceptions = str.NewWordMap(map[string][]string{
+	"лечь":  {"ляг"},
+	"есть":  {"ешь"},
+	"сесть": {"сядь"},
+	"дать":  {"дай"},
+	"быть":  {"будь"},
+	"ехать": {"езжай"},
+})
+
+/**
+ * Глаголы с безударным окончанием в повелительном наклонении, получающие -ь вместо -и: ставить - ставь.
+ * @var string[]
+ */
+var softSignImperatives = str.NewWordSet([]string{
+	"стать", "деть", "резать", "мазать", "плакать", "прятать", "лезть",
+	"ставить", "бавить", "править", "ответить", "отметить", "тратить",
+	"бросить", "верить", "готовить",
+	"кинуть", "двинуть", "глянуть", "плюнуть", "тронуть",
+})
+
+/**
+ * Глаголы, страдательные причастия которых образуются с суффиксом -т-: взять - взятый, выпить - выпитый.
+ * @var string[]
+ */
+var tParticipleVerbs = str.NewWordSet([]string{
+	"взять", "нять", "понять", "принять", "снять", "поднять", "отнять", "обнять", "занять", "нанять",
+	"начать", "мять", "жать", "бить", "пить", "лить", "шить", "вить", "брить", "деть", "греть",
+})
+
+/**
+ * Страдательные причастия прошедшего времени переходных глаголов на -йти: найти - найденный,
+ * обойти - обойдённый.
+ * @var string[]
+ */
+var passivePastExceptions = str.NewWordMap(map[string][]string{
+	"найти":     {"найденный"},
+	"пройти":    {"пройденный"},
+	"обойти":    {"обойдённый"},
+	"перейти":   {"перейдённый"},
+	"превзойти": {"превзойдённый"},
+})
+
+/**
+ * Непереходные глаголы движения и состояния, у которых нет страдательных причастий: прийти, приехать,
+ * стоять, умереть.
+ * @var string[]
+ */
+var intransitiveVerbs = str.NewWordSet([]string{
+	"йти", "идти", "ехать", "стоять", "спать", "мереть", "сидеть", "лежать", "висеть", "быть",
+	"сесть", "лечь", "пасть", "чезнуть", "гибнуть", "сохнуть", "мокнуть", "глохнуть", "киснуть", "расти",
+})
+
+/**
+ * Глаголы совершенного вида без приставки и основы, которые встречаются только в глаголах
+ * совершенного вида: дать, бросить, начать (на + чать), прийти (при + йти), получить (по + лучить).
+ * @var string[]
+ */
+var perfectiveVerbs = str.NewWordSet([]string{
+	"дать", "бросить", "решить", "купить", "кончить", "пустить", "лишить", "стать", "деть", "кинуть",
+	"вернуть", "сесть", "лечь", "пасть", "ступить", "хватить", "явить", "простить", "глянуть", "двинуть",
+	"взять", "чать", "нять", "йти", "лучить", "обидеть",
+})
+
+/**
+ * Глаголы несовершенного вида, которые с приставкой становятся глаголами совершенного вида:
+ * делать - сделать, писать - написать, вязать - связать. Глаголы движения вроде ходить, носить
+ * сюда не входят: приходить, приносить - несовершенный вид.
+ * @var string[]
+ */
+var prefixedPerfectiveVerbs = str.NewWordSet([]string{
+	"делать", "писать", "читать", "строить", "рисовать", "готовить", "учить", "варить", "звонить",
+	"смотреть", "видеть", "слышать", "ждать", "звать", "брать", "вязать", "ломать", "красить", "резать",
+	"петь", "бить", "лить", "пить", "мыть", "шить", "крыть", "думать", "работать", "играть", "просить",
+	"платить", "ставить", "класть", "будить", "ловить", "кормить", "любить", "терять", "хотеть", "жить",
+	"говорить", "знать", "нести", "везти", "вести", "ехать", "лететь", "бежать", "плыть", "есть",
+	"мочь", "печь", "жечь", "тереть", "жать", "казать",
+})
+
+/**
+ * Бесприставочные глаголы несовершенного вида, не вошедшие в список prefixedPerfectiveVerbs.
+ * Вид глаголов, которых нет ни в одном словаре, считается неизвестным.
+ * @var string[]
+ */
+var imperfectiveVerbs = str.NewWordSet([]string{
+	"идти", "брести", "ползти", "лезть", "гнать", "быть", "стоять", "сидеть", "лежать", "висеть", "спать",
+	"держать", "дышать", "кричать", "молчать", "стучать", "звучать", "дрожать", "шуметь", "гореть", "кипеть",
+	"терпеть", "зависеть", "ненавидеть", "вертеть", "глядеть", "греметь", "звенеть", "блестеть", "свистеть",
+	"скрипеть", "сопеть", "храпеть", "хрипеть", "пыхтеть", "визжать", "пищать", "рычать", "торчать", "ворчать",
+	"трещать", "журчать", "мчать", "боять", "велеть", "мять", "рвать", "врать", "лгать", "брить", "вить",
+	"расти", "цвести", "плести", "мести", "грести", "трясти", "пасти", "беречь", "стеречь", "течь", "влечь",
+	"сечь", "стричь", "волочь", "красть", "переть", "стелить", "солить", "тянуть", "тонуть", "гнуть", "сохнуть",
+	"мокнуть", "гибнуть", "глохнуть", "киснуть", "мёрзнуть", "мерзнуть", "дуть", "колоть", "бороть", "танцевать",
+	"воевать", "чувствовать", "советовать", "требовать", "плакать", "скакать", "прятать", "искать", "махать",
+	"пахать", "чесать", "шептать", "хохотать", "топтать", "щекотать", "клеветать", "полоскать", "трепать",
+	"сыпать", "глодать", "мазать", "лизать", "гулять", "ездить",
+})
+
+/**
+ * Глаголы движения, которые с приставкой остаются глаголами несовершенного вида: приходить, приносить.
+ * @var string[]
+ */
+var prefixedImperfectiveVerbs = str.NewWordSet([]string{
+	"ходить", "носить", "водить", "возить", "летать", "бегать", "плавать", "ползать",
+})
+
+/**
+ * Глаголы с нестандартным деепричастием настоящего времени: быть - будучи.
+ * @var string[]
+ */
+var presentGerundExceptions = str.NewWordMap(map[string][]string{
+	"быть": {"будучи"},
+})
+
+/**
+ * Глаголы несовершенного вида, у которых деепричастия настоящего времени нет: бить, пить, ждать.
+ * @var string[]
+ */
+var noPresentGerundVerbs = str.NewWordSet([]string{
+	"бить", "пить", "лить", "шить", "вить", "жать", "мять", "ждать", "рвать", "врать", "петь", "тереть",
+})
@@ -0,0 +1,126 @@
+package verb
+
+import (
+	"errors"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение деепричастия настоящего времени: делать - делая, говорить - говоря, быть - будучи.
+ * У глаголов совершенного вида деепричастия настоящего времени нет (сделать, дать), для них
+ * возвращается ошибка; деепричастие на -я (придя, принеся) дает GetPastGerund.
+ * Ошибка возвращается и для глаголов, вид которых не удалось определить (добавить, исчезнуть).
+ * @param string $infinitive
+ * @return string
+ * @throws \Exception
+ */
+func GetPresentGerund(inf str.Word) (string, error) {
+	w, _ := splitReflexive(inf.Lower())
+	if isPerfective(w) {
+		return inf.String(), errors.New("perfective verb has no present gerund: " + inf.String())
+	}
+	if !isImperfective(w) {
+		return inf.String(), errors.New("unknown aspect of " + inf.String())
+	}
+	if noPresentGerundVerbs.Has(w) {
+		return inf.String(), errors.New("verb has no present gerund: " + inf.String())
+	}
+	if presentGerundExceptions.Has(w) {
+		return presentGerundExceptions.SliceOf(w)[0], nil
+	}
+	return getStemGerund(inf)
+}
+
+/**
+ * Образование деепричастия от основы настоящего времени: делая, придя, принеся.
+ * @param string $infinitive
+ * @return string
+ * @throws \Exception
+ */
+func getStemGerund(inf str.Word) (string, error) {
+	w, reflexive := splitReflexive(inf.Lower())
+
+	form, err := presentGerund(w)
+	if err != nil {
+		return inf.String(), errors.New("unable to build present gerund of " + inf.String())
+	}
+	if reflexive {
+		form += "сь"
+	}
+	return form, nil
+}
+
+/**
+ * Получение деепричастия прошедшего времени: сделать - сделав, вернуться - вернувшись.
+ * Глаголы с основой прошедшего времени на согласную образуют его от основы настоящего: принести - принеся,
+ * глаголы на -нуть и -ереть - от основы инфинитива: исчезнуть - исчезнув, умереть - умерев.
+ * @param string $infinitive
+ * @return string
+ * @throws \Exception
+ */
+func GetPastGerund(inf str.Word) (string, error) {
+	w, reflexive := splitReflexive(inf.Lower())
+
+	past, err := GetPastForms(w)
+	if err != nil {
+		return inf.String(), errors.New("unable to build past gerund of " + inf.String())
+	}
+
+	masculine := str.Word(past[0])
+	switch {
+	// пришёл - придя, привёл - приведя
+	case hasDroppedStemConsonant(w, masculine):
+		return getStemGerund(inf)
+
+	case masculine.EndsWith(1, "л"):
+		if reflexive {
+			return masculine.Chars(0, -1) + "вшись", nil
+		}
+		return masculine.Chars(0, -1) + "в", nil
+
+	// исчез - исчезнув, умер - умерев
+	case w.EndsWith(4, "нуть") || w.EndsWith(5, "ереть"):
+		if reflexive {
+			return w.Chars(0, -2) + "вшись", nil
+		}
+		return w.Chars(0, -2) + "в", nil
+
+	// испечь - испёкши
+	case w.EndsWith(2, "чь"):
+		if reflexive {
+			return masculine.Concat("шись"), nil
+		}
+		return masculine.Concat("ши"), nil
+	}
+
+	return getStemGerund(inf)
+}
+
+/**
+ * @param string $verb Глагол без возвратного постфикса
+ * @return string
+ * @throws \Exception
+ */
+func presentGerund(w str.Word) (string, error) {
+	// давать - давая, вставать - вставая
+	if w.EndsWith(5, "авать") && !keepVaVerbs.Has(w) {
+		return w.Chars(0, -2) + "я", nil
+	}
+
+	forms, err := conjugate(w)
+	if err != nil {
+		return "", err
+	}
+
+	stem := str.Word(forms[5]).SliceWord(0, -2)
+	// от основ на г, к деепричастие не образуется: мочь - могут, печь - пекут
+	if russian.IsVelarConsonant(stem.LastChars(1)) {
+		return "", errors.New("no gerund for velar stem")
+	}
+	if russian.IsHissingConsonant(stem.LastChars(1)) {
+		return stem.Concat("а"), nil
+	}
+	return stem.Concat("я"), nil
+}
@@ -0,0 +1,94 @@
+package verb
+
+import (
+	"errors"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение формы повелительного наклонения: сделай - сделайте, пиши - пишите, встань, ляг.
+ * Единственное число - обращение на "ты", множественное - на "вы".
+ * @param string $infinitive
+ * @param string $number
+ * @return string
+ * @throws \Exception
+ */
+func GetImperative(inf str.Word, num number.Number) (string, error) {
+	w, reflexive := splitReflexive(inf.Lower())
+
+	form, err := imperative(w)
+	if err != nil {
+		return inf.String(), errors.New("unable to build imperative of " + inf.String())
+	}
+
+	if num == number.Plural {
+		form += "те"
+	}
+	if reflexive {
+		form = addReflexive(form)
+	}
+	return form, nil
+}
+
+/**
+ * Получение обеих форм повелительного наклонения.
+ * @param string $infinitive
+ * @return string[] на "ты", на "вы"
+ * @throws \Exception
+ */
+func GetImperatives(inf str.Word) ([]string, error) {
+	informal, err := GetImperative(inf, number.Singular)
+	if err != nil {
+		return nil, err
+	}
+	formal, err := GetImperative(inf, number.Plural)
+	if err != nil {
+		return nil, err
+	}
+	return []string{informal, formal}, nil
+}
+
+/**
+ * Форма на "ты" строится от основы настоящего (простого будущего) времени (читают - читай, пишут - пиши).
+ * @param string $verb Глагол без возвратного постфикса
+ * @return string
+ * @throws \Exception
+ */
+func imperative(w str.Word) (string, error) {
+	if prefix, base, has := findPrefixed(w, imperativeExceptions.Has); has {
+		form := imperativeExceptions.SliceOf(base)[0]
+		// ехать - поезжай, но приехать - приезжай
+		if prefix == "" && base.String() == "ехать" {
+			prefix = "по"
+		}
+		return withPrefix(prefix, base, form), nil
+	}
+
+	// давать - давай, вставать - вставай
+	if w.EndsWith(5, "авать") && !keepVaVerbs.Has(w) {
+		return w.Chars(0, -2) + "й", nil
+	}
+
+	forms, err := conjugate(w)
+	if err != nil {
+		return "", err
+	}
+	stem := str.Word(forms[5]).SliceWord(0, -2)
+
+	switch {
+	// пьют - пей, шьют - шей
+	case stem.EndsWith(1, "ь"):
+		return stem.Chars(0, -1) + "ей", nil
+	// читают - читай, стоят - стой
+	case russian.IsVowel(stem.LastChars(1)):
+		return stem.Concat("й"), nil
+	}
+
+	if _, _, has := findPrefixed(w, softSignImperatives.Has); has {
+		return stem.Concat("ь"), nil
+	}
+	return stem.Concat("и"), nil
+}
@@ -0,0 +1,49 @@
+package verb
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetImperatives(t *testing.T) {
+	tests := []struct {
+		Verb  string
+		Forms []string
+	}{
+		{Verb: "сделать", Forms: []string{"сделай", "сделайте"}},
+		{Verb: "писать", Forms: []string{"пиши", "пишите"}},
+		{Verb: "встать", Forms: []string{"встань", "встаньте"}},
+		{Verb: "лечь", Forms: []string{"ляг", "лягте"}},
+		{Verb: "давать", Forms: []string{"давай", "давайте"}},
+		{Verb: "выпить", Forms: []string{"выпей", "выпейте"}},
+		{Verb: "открыть", Forms: []string{"открой", "откройте"}},
+		{Verb: "приехать", Forms: []string{"приезжай", "приезжайте"}},
+		{Verb: "отправить", Forms: []string{"отправь", "отправьте"}},
+		{Verb: "взять", Forms: []string{"возьми", "возьмите"}},
+		{Verb: "учиться", Forms: []string{"учись", "учитесь"}},
+		{Verb: "одеться", Forms: []string{"оденься", "оденьтесь"}},
+		{Verb: "кончить", Forms: []string{"кончи", "кончите"}},
+		{Verb: "кинуть", Forms: []string{"кинь", "киньте"}},
+		{Verb: "закинуть", Forms: []string{"закинь", "закиньте"}},
+		{Verb: "солить", Forms: []string{"соли", "солите"}},
+		{Verb: "сжать", Forms: []string{"сожми", "сожмите"}},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Verb, func(t *testing.T) {
+			forms, err := GetImperatives(str.Word(tst.Verb))
+			require.NoError(t, err)
+			assert.Equal(t, tst.Forms, forms)
+		})
+	}
+}
+
+func Test_GetImperative(t *testing.T) {
+	form, err := GetImperative(str.Word("Сохранить"), number.Plural)
+	require.NoError(t, err)
+	assert.Equal(t, "сохраните", form)
+}
@@ -0,0 +1,172 @@
+package verb
+
+import (
+	"errors"
+
+	"github.com/dshipenok/gomorphos/russian/adjective"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение начальной формы причастия: делающий, делавший, делаемый, сделанный.
+ * Вид причастия задается константами adjective.ActivePresent, adjective.ActivePast,
+ * adjective.PassivePresent, adjective.PassivePast. Полученное причастие склоняется через adjective.GetCases.
+ * @param string $infinitive
+ * @param int $type Вид причастия
+ * @return string
+ * @throws \Exception
+ */
+func GetParticiple(inf str.Word, participleType int) (string, error) {
+	w, reflexive := splitReflexive(inf.Lower())
+
+	var (
+		form string
+		err  error
+	)
+	// причастий настоящего времени у глаголов совершенного вида нет: сделать, связаться
+	if (participleType == adjective.ActivePresent || participleType == adjective.PassivePresent) && isPerfective(w) {
+		return inf.String(), errors.New("perfective verb has no present participle: " + inf.String())
+	}
+	if (participleType == adjective.ActivePresent || participleType == adjective.PassivePresent) && !isImperfective(w) {
+		return inf.String(), errors.New("unknown aspect of " + inf.String())
+	}
+
+	switch participleType {
+	case adjective.ActivePresent:
+		form, err = activePresentParticiple(w)
+	case adjective.ActivePast:
+		form, err = activePastParticiple(w)
+	case adjective.PassivePresent, adjective.PassivePast:
+		if reflexive {
+			return inf.String(), errors.New("reflexive verbs have no passive participles")
+		}
+		if participleType == adjective.PassivePresent {
+			form, err = passivePresentParticiple(w)
+		} else {
+			form, err = passivePastParticiple(w)
+		}
+	default:
+		return inf.String(), errors.New("invalid participle type")
+	}
+
+	if err != nil {
+		return inf.String(), errors.New("unable to build participle of " + inf.String())
+	}
+	if reflexive {
+		form += "ся"
+	}
+	return form, nil
+}
+
+/**
+ * Действительное причастие настоящего времени: делают - делающий, говорят - говорящий.
+ * @param string $verb
+ * @return string
+ * @throws \Exception
+ */
+func activePresentParticiple(w str.Word) (string, error) {
+	forms, err := conjugate(w)
+	if err != nil {
+		return "", err
+	}
+	return str.Word(forms[5]).Chars(0, -1) + "щий", nil
+}
+
+/**
+ * Действительное причастие прошедшего времени: делал - делавший, принёс - принёсший, пришёл - пришедший,
+ * вёл - ведший.
+ * @param string $verb
+ * @return string
+ * @throws \Exception
+ */
+func activePastParticiple(w str.Word) (string, error) {
+	past, err := GetPastForms(w)
+	if err != nil {
+		return "", err
+	}
+
+	masculine := str.Word(past[0])
+	switch {
+	case isShelPast(masculine):
+		return masculine.Chars(0, -3) + "шедший", nil
+	// вёл - ведут - ведший, цвёл - цветут - цветший
+	case hasDroppedStemConsonant(w, masculine):
+		forms, err := conjugate(w)
+		if err != nil {
+			return "", err
+		}
+		return str.Word(forms[5]).Chars(0, -2) + "ший", nil
+	case masculine.EndsWith(1, "л"):
+		return masculine.Chars(0, -1) + "вший", nil
+	}
+	return masculine.Concat("ший"), nil
+}
+
+/**
+ * Страдательное причастие настоящего времени: делаем - делаемый, любим - любимый.
+ * @param string $verb
+ * @return string
+ * @throws \Exception
+ */
+func passivePresentParticiple(w str.Word) (string, error) {
+	// давать - даваемый
+	if w.EndsWith(5, "авать") && !keepVaVerbs.Has(w) {
+		return w.Chars(0, -2) + "емый", nil
+	}
+
+	forms, err := conjugate(w)
+	if err != nil {
+		return "", err
+	}
+	return str.Word(forms[3]).Concat("ый"), nil
+}
+
+/**
+ * Страдательное причастие прошедшего времени: сделать - сделанный, получить - полученный,
+ * открыть - открытый, принести - принесённый, найти - найденный.
+ * У непереходных глаголов (прийти, стоять) страдательных причастий нет.
+ * @param string $verb
+ * @return string
+ * @throws \Exception
+ */
+func passivePastParticiple(w str.Word) (string, error) {
+	if passivePastExceptions.Has(w) {
+		return passivePastExceptions.SliceOf(w)[0], nil
+	}
+	if _, _, has := findPrefixed(w, intransitiveVerbs.Has); has {
+		return "", errors.New("intransitive verb has no passive participles")
+	}
+	if _, _, has := findPrefixed(w, tParticipleVerbs.Has); has {
+		return w.Chars(0, -2) + "тый", nil
+	}
+
+	switch {
+	case w.EndsWith(3, "ыть", "уть", "оть"):
+		return w.Chars(0, -2) + "тый", nil
+
+	case w.EndsWith(3, "ать", "ять"):
+		return w.Chars(0, -2) + "нный", nil
+
+	// увидеть - увиденный
+	case w.EndsWith(3, "еть"):
+		return w.Chars(0, -3) + "енный", nil
+
+	// получить - получу - полученный, купить - куплю - купленный
+	case w.EndsWith(3, "ить"):
+		forms, err := conjugate(w)
+		if err != nil {
+			return "", err
+		}
+		return str.Word(forms[0]).Chars(0, -1) + "енный", nil
+
+	// принести - принесёшь - принесённый, испечь - испечёшь - испечённый
+	case w.EndsWith(2, "ти", "чь"):
+		forms, err := conjugate(w)
+		if err != nil {
+			return "", err
+		}
+		return str.Word(forms[1]).Chars(0, -3) + "ённый", nil
+	}
+
+	return "", errors.New("unknown verb")
+}
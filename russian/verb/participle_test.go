@@ -0,0 +1,140 @@
+package verb
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/adjective"
+	"github.com/dshipenok/gomorphos/russian/cases"
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+/** Формы нет, ожидается ошибка */
+const unsupported = "-"
+
+func Test_GetGerunds(t *testing.T) {
+	tests := []struct {
+		Verb    string
+		Present string
+		Past    string
+	}{
+		{Verb: "делать", Present: "делая", Past: "делав"},
+		{Verb: "говорить", Present: "говоря", Past: "говорив"},
+		{Verb: "слышать", Present: "слыша", Past: "слышав"},
+		{Verb: "давать", Present: "давая", Past: "давав"},
+		{Verb: "прийти", Present: unsupported, Past: "придя"},
+		{Verb: "выйти", Present: unsupported, Past: "выйдя"},
+		{Verb: "вести", Present: "ведя", Past: "ведя"},
+		{Verb: "привести", Present: unsupported, Past: "приведя"},
+		{Verb: "принести", Present: unsupported, Past: "принеся"},
+		{Verb: "вернуться", Present: unsupported, Past: "вернувшись"},
+		{Verb: "учиться", Present: "учась", Past: "учившись"},
+		{Verb: "сделать", Present: unsupported, Past: "сделав"},
+		{Verb: "дать", Present: unsupported, Past: "дав"},
+		{Verb: "связаться", Present: unsupported, Past: "связавшись"},
+		{Verb: "начаться", Present: unsupported, Past: "начавшись"},
+		{Verb: "мочь", Present: unsupported},
+		{Verb: "быть", Present: "будучи", Past: "быв"},
+		{Verb: "бить", Present: unsupported, Past: "бив"},
+		{Verb: "сжать", Present: unsupported, Past: "сжав"},
+		{Verb: "добавить", Present: unsupported, Past: "добавив"},
+		{Verb: "умереть", Present: unsupported, Past: "умерев"},
+		{Verb: "стереть", Present: unsupported, Past: "стерев"},
+		{Verb: "исчезнуть", Present: unsupported, Past: "исчезнув"},
+		{Verb: "привыкнуть", Present: unsupported, Past: "привыкнув"},
+		{Verb: "достигнуть", Present: unsupported, Past: "достигнув"},
+		{Verb: "улыбнуться", Present: unsupported, Past: "улыбнувшись"},
+		{Verb: "понимать", Present: "понимая"},
+		{Verb: "приходить", Present: "приходя"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Verb, func(t *testing.T) {
+			present, err := GetPresentGerund(str.Word(tst.Verb))
+			if tst.Present == unsupported {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tst.Present, present)
+			}
+
+			if tst.Past != "" {
+				past, err := GetPastGerund(str.Word(tst.Verb))
+				require.NoError(t, err)
+				assert.Equal(t, tst.Past, past)
+			}
+		})
+	}
+}
+
+func Test_GetParticiple(t *testing.T) {
+	tests := []struct {
+		Verb        string
+		Participles []string
+	}{
+		{Verb: "делать", Participles: []string{"делающий", "делавший", "делаемый", "деланный"}},
+		{Verb: "читать", Participles: []string{"читающий", "читавший", "читаемый", "читанный"}},
+		{Verb: "любить", Participles: []string{"любящий", "любивший", "любимый", ""}},
+		{Verb: "писать", Participles: []string{"пишущий", "писавший", "", "писанный"}},
+		{Verb: "давать", Participles: []string{"дающий", "дававший", "даваемый", ""}},
+		{Verb: "сделать", Participles: []string{unsupported, "сделавший", unsupported, "сделанный"}},
+		{Verb: "получить", Participles: []string{unsupported, "получивший", unsupported, "полученный"}},
+		{Verb: "бросить", Participles: []string{unsupported, "бросивший", unsupported, "брошенный"}},
+		{Verb: "открыть", Participles: []string{unsupported, "открывший", unsupported, "открытый"}},
+		{Verb: "взять", Participles: []string{unsupported, "взявший", unsupported, "взятый"}},
+		{Verb: "принести", Participles: []string{unsupported, "принёсший", unsupported, "принесённый"}},
+		{Verb: "прийти", Participles: []string{unsupported, "пришедший", unsupported, unsupported}},
+		{Verb: "найти", Participles: []string{unsupported, "нашедший", unsupported, "найденный"}},
+		{Verb: "пройти", Participles: []string{unsupported, "прошедший", unsupported, "пройденный"}},
+		{Verb: "приехать", Participles: []string{"", "приехавший", "", unsupported}},
+		{Verb: "стоять", Participles: []string{"стоящий", "стоявший", "", unsupported}},
+		{Verb: "спать", Participles: []string{"спящий", "спавший", "", unsupported}},
+		{Verb: "умереть", Participles: []string{unsupported, "", unsupported, unsupported}},
+		{Verb: "выйти", Participles: []string{unsupported, "вышедший", unsupported, ""}},
+		{Verb: "вести", Participles: []string{"ведущий", "ведший", "", ""}},
+		{Verb: "цвести", Participles: []string{"цветущий", "цветший", "", ""}},
+		{Verb: "учиться", Participles: []string{"учащийся", "учившийся", "", ""}},
+		{Verb: "связаться", Participles: []string{unsupported, "связавшийся", unsupported, ""}},
+		{Verb: "дать", Participles: []string{unsupported, "давший", unsupported, "данный"}},
+		{Verb: "добавить", Participles: []string{unsupported, "добавивший", unsupported, ""}},
+	}
+
+	participleTypes := []int{adjective.ActivePresent, adjective.ActivePast, adjective.PassivePresent, adjective.PassivePast}
+	for _, tst := range tests {
+		t.Run(tst.Verb, func(t *testing.T) {
+			for i, participleType := range participleTypes {
+				if tst.Participles[i] == "" {
+					continue
+				}
+				participle, err := GetParticiple(str.Word(tst.Verb), participleType)
+				if tst.Participles[i] == unsupported {
+					assert.Error(t, err)
+					continue
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tst.Participles[i], participle)
+			}
+		})
+	}
+
+	_, err := GetParticiple(str.Word("учиться"), adjective.PassivePast)
+	assert.Error(t, err)
+}
+
+func Test_GetParticiple_Declension(t *testing.T) {
+	participle, err := GetParticiple(str.Word("сделать"), adjective.PassivePast)
+	require.NoError(t, err)
+
+	forms, err := adjective.GetCases(str.Word(participle), false, gender.Female)
+	require.NoError(t, err)
+	assert.Equal(t, "сделанной", forms[cases.Rodit])
+
+	participle, err = GetParticiple(str.Word("делать"), adjective.ActivePresent)
+	require.NoError(t, err)
+
+	forms, err = adjective.GetCases(str.Word(participle), false, gender.Male)
+	require.NoError(t, err)
+	assert.Equal(t, "делающего", forms[cases.Rodit])
+}
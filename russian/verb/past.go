@@ -0,0 +1,128 @@
+package verb
+
+import (
+	"errors"
+
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+/**
+ * Получение формы прошедшего времени: сделал, сделала, сделало, сделали.
+ * Если род не определен (gender.Invalid), используется мужской род.
+ * @param string $infinitive
+ * @param string $gender
+ * @param string $number
+ * @return string
+ * @throws \Exception
+ */
+func GetPast(inf str.Word, gendr gender.Gender, num number.Number) (string, error) {
+	forms, err := GetPastForms(inf)
+	if err != nil {
+		return inf.String(), err
+	}
+
+	if num == number.Plural {
+		return forms[3], nil
+	}
+	switch gendr {
+	case gender.Female:
+		return forms[1], nil
+	case gender.Neuter:
+		return forms[2], nil
+	}
+	return forms[0], nil
+}
+
+/**
+ * Получение всех форм прошедшего времени.
+ * @param string $infinitive
+ * @return string[] мужской, женский, средний род, множественное число
+ * @throws \Exception
+ */
+func GetPastForms(inf str.Word) ([]string, error) {
+	w, reflexive := splitReflexive(inf.Lower())
+
+	var masculine, stem string
+	if prefix, base, has := findPrefixed(w, pastExceptions.Has); has {
+		values := pastExceptions.SliceOf(base)
+		masculine, stem = withPrefix(prefix, base, values[0]), withPrefix(prefix, base, values[1])
+	} else if w.EndsWith(2, "ть") {
+		// прошедшее время глаголов на -ти, -чь не выводится из инфинитива (приобрести - приобрёл),
+		// поэтому они берутся только из таблицы исключений
+		stem = w.Chars(0, -2) + "л"
+		masculine = stem
+	} else {
+		return nil, errors.New("unable to build past tense of " + inf.String())
+	}
+
+	forms := []string{masculine, stem + "а", stem + "о", stem + "и"}
+	if reflexive {
+		forms[0] += "ся"
+		for i := 1; i < len(forms); i++ {
+			forms[i] += "сь"
+		}
+	}
+	return forms, nil
+}
+
+/**
+ * Проверка, образовано ли прошедшее время от -йти, -идти: шёл, пришёл, вышел (под ударной
+ * приставкой вы- ё не сохраняется).
+ * @param string $masculine Форма мужского рода
+ * @return bool
+ */
+func isShelPast(masculine str.Word) bool {
+	return masculine.EndsWith(3, "шёл", "шел")
+}
+
+/**
+ * Проверка, выпадает ли в прошедшем времени согласная основы настоящего времени:
+ * шёл - идут, вёл - ведут, цвёл - цветут. Деепричастия и причастия таких глаголов
+ * образуются от основы настоящего времени: придя, приведя, ведший.
+ * @param string $verb Глагол без возвратного постфикса
+ * @param string $masculine Форма прошедшего времени мужского рода
+ * @return bool
+ */
+func hasDroppedStemConsonant(w, masculine str.Word) bool {
+	return isShelPast(masculine) || (w.EndsWith(3, "сти") && masculine.EndsWith(1, "л"))
+}
+
+/**
+ * Отделение возвратного постфикса -ся/-сь.
+ * @param string $verb
+ * @return array
+ */
+func splitReflexive(w str.Word) (str.Word, bool) {
+	if w.Len() > 4 && w.EndsWith(2, "ся", "сь") {
+		return w.SliceWord(0, -2), true
+	}
+	return w, false
+}
+
+/**
+ * Поиск глагола в таблице исключений: сам глагол либо приставка + глагол из таблицы.
+ * Выбирается самое длинное совпадение (привлечь = при + влечь, а не прив + лечь).
+ * Приставка с беглой -о- отделяется только перед стечением согласных: собрать = со + брать,
+ * но солить, сопеть - не со + лить, со + петь.
+ * @param string $verb
+ * @param callable $has Проверка наличия глагола в таблице
+ * @return array приставка, глагол из таблицы, признак успеха
+ */
+func findPrefixed(w str.Word, has func(base str.Word) bool) (string, str.Word, bool) {
+	for i := 0; i < w.Len(); i++ {
+		prefix := w.Chars(0, i)
+		if prefix != "" && !prefixes.HasStr(prefix) {
+			continue
+		}
+		base := w.SubWord(i)
+		if fleetingVowelPrefixes.Has(str.Word(prefix)) && !startsWithCluster(base.String()) {
+			continue
+		}
+		if has(base) {
+			return prefix, base, true
+		}
+	}
+	return "", nil, false
+}
@@ -0,0 +1,57 @@
+package verb
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/gender"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetPastForms(t *testing.T) {
+	tests := []struct {
+		Verb  string
+		Forms []string
+	}{
+		{Verb: "сделать", Forms: []string{"сделал", "сделала", "сделало", "сделали"}},
+		{Verb: "прийти", Forms: []string{"пришёл", "пришла", "пришло", "пришли"}},
+		{Verb: "выйти", Forms: []string{"вышел", "вышла", "вышло", "вышли"}},
+		{Verb: "мочь", Forms: []string{"мог", "могла", "могло", "могли"}},
+		{Verb: "помочь", Forms: []string{"помог", "помогла", "помогло", "помогли"}},
+		{Verb: "нести", Forms: []string{"нёс", "несла", "несло", "несли"}},
+		{Verb: "привлечь", Forms: []string{"привлёк", "привлекла", "привлекло", "привлекли"}},
+		{Verb: "исчезнуть", Forms: []string{"исчез", "исчезла", "исчезло", "исчезли"}},
+		{Verb: "учиться", Forms: []string{"учился", "училась", "училось", "учились"}},
+		{Verb: "ошибиться", Forms: []string{"ошибся", "ошиблась", "ошиблось", "ошиблись"}},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Verb, func(t *testing.T) {
+			forms, err := GetPastForms(str.Word(tst.Verb))
+
+			require.NoError(t, err)
+			assert.Equal(t, tst.Forms, forms)
+		})
+	}
+}
+
+func Test_GetPast(t *testing.T) {
+	past, err := GetPast(str.Word("добавить"), gender.Female, number.Singular)
+	require.NoError(t, err)
+	assert.Equal(t, "добавила", past)
+
+	past, err = GetPast(str.Word("прийти"), gender.Invalid, number.Plural)
+	require.NoError(t, err)
+	assert.Equal(t, "пришли", past)
+
+	_, err = GetPast(str.Word("стол"), gender.Male, number.Singular)
+	assert.Error(t, err)
+
+	// -ти, -чь вне таблицы исключений: приобрести - приобрёл, а не приобрестл
+	_, err = GetPast(str.Word("приобрести"), gender.Male, number.Singular)
+	assert.Error(t, err)
+	_, err = GetPast(str.Word("толочь"), gender.Male, number.Singular)
+	assert.Error(t, err)
+}
@@ -0,0 +1,292 @@
+package verb
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/dshipenok/gomorphos/russian"
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+)
+
+var (
+	firstEndings          = []string{"ю", "ешь", "ет", "ем", "ете", "ют"}
+	firstStressedEndings  = []string{"ю", "ёшь", "ёт", "ём", "ёте", "ют"}
+	firstConsonantEndings = []string{"у", "ешь", "ет", "ем", "ете", "ут"}
+)
+
+/**
+ * Получение формы настоящего (для совершенного вида - простого будущего) времени:
+ * получить - вы получите, ты получишь.
+ * @param string $infinitive
+ * @param int $person Лицо
+ * @param string $number Число
+ * @return string
+ * @throws \Exception
+ */
+func Conjugate(inf str.Word, person Person, num number.Number) (string, error) {
+	forms, err := GetConjugation(inf)
+	if err != nil {
+		return inf.String(), err
+	}
+
+	index := int(person) - 1
+	if index < 0 || index > 2 {
+		return inf.String(), errors.New("invalid person")
+	}
+	if num == number.Plural {
+		index += 3
+	}
+	return forms[index], nil
+}
+
+/**
+ * Получение всех личных форм настоящего (простого будущего) времени.
+ * @param string $infinitive
+ * @return string[] я, ты, он, мы, вы, они
+ * @throws \Exception
+ */
+func GetConjugation(inf str.Word) ([]string, error) {
+	w, reflexive := splitReflexive(inf.Lower())
+
+	forms, err := conjugate(w)
+	if err != nil {
+		return nil, errors.New("unable to conjugate " + inf.String())
+	}
+
+	if reflexive {
+		for i, form := range forms {
+			forms[i] = addReflexive(form)
+		}
+	}
+	return forms, nil
+}
+
+/**
+ * Определение спряжения глагола по окончанию второго лица: -ешь - первое, -ишь - второе.
+ * @param string $infinitive
+ * @return int
+ * @throws \Exception
+ */
+func GetConjugationClass(inf str.Word) (int, error) {
+	w, _ := splitReflexive(inf.Lower())
+	forms, err := conjugate(w)
+	if err != nil {
+		return 0, err
+	}
+
+	if str.Word(forms[1]).EndsWith(3, "ишь") {
+		return SecondConjugation, nil
+	}
+	return FirstConjugation, nil
+}
+
+/**
+ * Определение совершенного вида по словарю: бесприставочные глаголы совершенного вида (дать, бросить)
+ * и приставка + глагол, который с приставкой становится совершенным (сделать, связать).
+ * @param string $verb Глагол без возвратного постфикса
+ * @return bool
+ */
+func isPerfective(w str.Word) bool {
+	if _, _, has := findPrefixed(w, perfectiveVerbs.Has); has {
+		return true
+	}
+	prefix, _, has := findPrefixed(w, prefixedPerfectiveVerbs.Has)
+	return has && prefix != ""
+}
+
+/**
+ * Определение несовершенного вида по словарю: бесприставочные глаголы (делать, стоять), глаголы
+ * с суффиксами -ыва-, -ива-, -ва- и -има-, -ира-, -ина- (показывать, давать, понимать, умирать, начинать)
+ * и глаголы движения с приставкой (приходить). Если глагол не найден ни здесь, ни в isPerfective,
+ * вид считается неизвестным: добавить, исчезнуть.
+ * @param string $verb Глагол без возвратного постфикса
+ * @return bool
+ */
+func isImperfective(w str.Word) bool {
+	if isPerfective(w) {
+		return false
+	}
+	if w.EndsWith(5, "ывать", "ивать", "авать", "имать", "ирать", "инать") {
+		return true
+	}
+	if imperfectiveVerbs.Has(w) || prefixedPerfectiveVerbs.Has(w) {
+		return true
+	}
+	_, _, has := findPrefixed(w, prefixedImperfectiveVerbs.Has)
+	return has
+}
+
+/**
+ * @param string $verb Глагол без возвратного постфикса
+ * @return string[]
+ * @throws \Exception
+ */
+func conjugate(w str.Word) ([]string, error) {
+	// глагол целиком важнее приставки + исключения: сопеть - соплю, а не со + петь
+	if secondConjugationExceptions.Has(w) {
+		return conjugateSecond(w.SliceWord(0, -3)), nil
+	}
+	if prefix, base, has := findPrefixed(w, presentExceptions.Has); has {
+		forms := make([]string, 0, 6)
+		for _, form := range presentExceptions.SliceOf(base) {
+			forms = append(forms, withPrefix(prefix, base, form))
+		}
+		return forms, nil
+	}
+
+	if prefix, base, has := findPrefixed(w, presentStems.Has); has {
+		return withEndings(prefix+presentStems.SliceOf(base)[0], firstConsonantEndings), nil
+	}
+
+	switch {
+	// рисовать - рисую, танцевать - танцую, воевать - воюю
+	case w.EndsWith(5, "овать") ||
+		(w.EndsWith(5, "евать") && (russian.IsHissingConsonant(w.Chars(-6, -5)) || w.Chars(-6, -5) == "ц")):
+		return withEndings(w.Chars(0, -5)+"у", firstEndings), nil
+	case w.EndsWith(5, "евать") && russian.IsVowel(w.Chars(-6, -5)):
+		return withEndings(w.Chars(0, -5)+"ю", firstEndings), nil
+
+	// давать - даю, вставать - встаю
+	case w.EndsWith(5, "авать") && !keepVaVerbs.Has(w):
+		return withEndings(w.Chars(0, -4), firstStressedEndings), nil
+
+	case w.EndsWith(3, "ать", "ять", "еть"):
+		if _, _, has := findPrefixed(w, secondConjugationExceptions.Has); has {
+			return conjugateSecond(w.SliceWord(0, -3)), nil
+		}
+		// сеять - сею, таять - таю
+		if w.EndsWith(3, "ять") && russian.IsVowel(w.Chars(-4, -3)) {
+			return withEndings(w.Chars(0, -3), firstEndings), nil
+		}
+		return withEndings(w.Chars(0, -2), firstEndings), nil
+
+	case w.EndsWith(3, "ить"):
+		if firstConjugationExceptions.Has(w) {
+			return withEndings(w.Chars(0, -3), firstEndings), nil
+		}
+		return conjugateSecond(w.SliceWord(0, -3)), nil
+
+	// колоть - колю, бороться - борюсь
+	case w.EndsWith(3, "оть"):
+		return withEndings(w.Chars(0, -3), firstEndings), nil
+
+	// мыть - мою, открыть - открою
+	case w.EndsWith(3, "ыть"):
+		return withEndings(w.Chars(0, -3)+"о", firstEndings), nil
+
+	// тянуть - тяну, вернуть - верну
+	case w.EndsWith(4, "нуть"):
+		return withEndings(w.Chars(0, -3), firstConsonantEndings), nil
+
+	// дуть - дую
+	case w.EndsWith(3, "уть"):
+		return withEndings(w.Chars(0, -2), firstEndings), nil
+	}
+
+	return nil, errors.New("unknown verb")
+}
+
+/**
+ * Формы второго спряжения с чередованием согласной в первом лице: любить - люблю, платить - плачу.
+ * @param string $stem
+ * @return string[]
+ */
+func conjugateSecond(stem str.Word) []string {
+	first := alternateConsonant(stem)
+
+	last := str.Word(first).LastChars(1)
+	firstEnding := "ю"
+	if russian.IsHissingConsonant(last) {
+		firstEnding = "у"
+	}
+	pluralEnding := "ят"
+	if russian.IsHissingConsonant(stem.LastChars(1)) {
+		pluralEnding = "ат"
+	}
+
+	s := stem.String()
+	return []string{first + firstEnding, s + "ишь", s + "ит", s + "им", s + "ите", s + pluralEnding}
+}
+
+/**
+ * Чередование согласных в первом лице глаголов второго спряжения.
+ * @param string $stem
+ * @return string
+ */
+func alternateConsonant(stem str.Word) string {
+	switch {
+	case stem.EndsWith(2, "ст"):
+		return stem.Chars(0, -2) + "щ"
+	case stem.EndsWith(2, "зд"):
+		return stem.Chars(0, -2) + "зж"
+	}
+
+	prefix := stem.Chars(0, -1)
+	switch stem.LastChars(1) {
+	case "б", "п", "в", "ф", "м":
+		return stem.String() + "л"
+	case "с":
+		return prefix + "ш"
+	case "з", "д":
+		return prefix + "ж"
+	case "т":
+		return prefix + "ч"
+	}
+	return stem.String()
+}
+
+/**
+ * @param string $stem
+ * @param string[] $endings
+ * @return string[]
+ */
+func withEndings(stem string, endings []string) []string {
+	forms := make([]string, len(endings))
+	for i, ending := range endings {
+		forms[i] = stem + ending
+	}
+	return forms
+}
+
+/**
+ * Приставка к форме из таблицы исключений; под ударной приставкой вы- ё не сохраняется (выпьешь),
+ * перед стечением согласных, которого нет в инфинитиве, появляется беглая -о-: стереть - сотру,
+ * разбить - разобью, но встать - встану.
+ * @param string $prefix
+ * @param string $verb Глагол из таблицы исключений
+ * @param string $form
+ * @return string
+ */
+func withPrefix(prefix string, base str.Word, form string) string {
+	if prefix == "вы" {
+		form = strings.Replace(form, "ё", "е", -1)
+	}
+	if fleetingVowelPrefixes.Has(str.Word(prefix+"о")) && startsWithCluster(form) && !startsWithCluster(base.String()) {
+		prefix += "о"
+	}
+	return prefix + form
+}
+
+/**
+ * Проверка, начинается ли форма со стечения согласных: тру, жму, бью.
+ * @param string $form
+ * @return bool
+ */
+func startsWithCluster(form string) bool {
+	w := str.Word(form)
+	return w.Len() > 1 && russian.IsConsonant(w.Chars(0, 1)) &&
+		(russian.IsConsonant(w.Chars(1, 2)) || w.Chars(1, 2) == "ь")
+}
+
+/**
+ * Возвратный постфикс: -сь после гласной, -ся после согласной.
+ * @param string $form
+ * @return string
+ */
+func addReflexive(form string) string {
+	if russian.IsVowel(str.Word(form).LastChars(1)) {
+		return form + "сь"
+	}
+	return form + "ся"
+}
@@ -0,0 +1,66 @@
+package verb
+
+import (
+	"testing"
+
+	"github.com/dshipenok/gomorphos/russian/number"
+	"github.com/dshipenok/gomorphos/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetConjugation(t *testing.T) {
+	tests := []struct {
+		Verb  string
+		Class int
+		Forms []string
+	}{
+		{Verb: "читать", Class: FirstConjugation, Forms: []string{"читаю", "читаешь", "читает", "читаем", "читаете", "читают"}},
+		{Verb: "писать", Class: FirstConjugation, Forms: []string{"пишу", "пишешь", "пишет", "пишем", "пишете", "пишут"}},
+		{Verb: "рисовать", Class: FirstConjugation, Forms: []string{"рисую", "рисуешь", "рисует", "рисуем", "рисуете", "рисуют"}},
+		{Verb: "вставать", Class: FirstConjugation, Forms: []string{"встаю", "встаёшь", "встаёт", "встаём", "встаёте", "встают"}},
+		{Verb: "любить", Class: SecondConjugation, Forms: []string{"люблю", "любишь", "любит", "любим", "любите", "любят"}},
+		{Verb: "получить", Class: SecondConjugation, Forms: []string{"получу", "получишь", "получит", "получим", "получите", "получат"}},
+		{Verb: "смотреть", Class: SecondConjugation, Forms: []string{"смотрю", "смотришь", "смотрит", "смотрим", "смотрите", "смотрят"}},
+		{Verb: "учиться", Class: SecondConjugation, Forms: []string{"учусь", "учишься", "учится", "учимся", "учитесь", "учатся"}},
+		{Verb: "хотеть", Class: FirstConjugation, Forms: []string{"хочу", "хочешь", "хочет", "хотим", "хотите", "хотят"}},
+		{Verb: "создать", Class: FirstConjugation, Forms: []string{"создам", "создашь", "создаст", "создадим", "создадите", "создадут"}},
+		{Verb: "бежать", Class: SecondConjugation, Forms: []string{"бегу", "бежишь", "бежит", "бежим", "бежите", "бегут"}},
+		{Verb: "сопеть", Class: SecondConjugation, Forms: []string{"соплю", "сопишь", "сопит", "сопим", "сопите", "сопят"}},
+		{Verb: "выпить", Class: FirstConjugation, Forms: []string{"выпью", "выпьешь", "выпьет", "выпьем", "выпьете", "выпьют"}},
+		{Verb: "солить", Class: SecondConjugation, Forms: []string{"солю", "солишь", "солит", "солим", "солите", "солят"}},
+		{Verb: "стереть", Class: FirstConjugation, Forms: []string{"сотру", "сотрёшь", "сотрёт", "сотрём", "сотрёте", "сотрут"}},
+		{Verb: "сжать", Class: FirstConjugation, Forms: []string{"сожму", "сожмёшь", "сожмёт", "сожмём", "сожмёте", "сожмут"}},
+		{Verb: "разбить", Class: FirstConjugation, Forms: []string{"разобью", "разобьёшь", "разобьёт", "разобьём", "разобьёте", "разобьют"}},
+		{Verb: "собрать", Class: FirstConjugation, Forms: []string{"соберу", "соберёшь", "соберёт", "соберём", "соберёте", "соберут"}},
+		{Verb: "класть", Class: FirstConjugation, Forms: []string{"кладу", "кладёшь", "кладёт", "кладём", "кладёте", "кладут"}},
+		{Verb: "стеречь", Class: FirstConjugation, Forms: []string{"стерегу", "стережёшь", "стережёт", "стережём", "стережёте", "стерегут"}},
+		{Verb: "сечь", Class: FirstConjugation, Forms: []string{"секу", "сечёшь", "сечёт", "сечём", "сечёте", "секут"}},
+		{Verb: "волочь", Class: FirstConjugation, Forms: []string{"волоку", "волочёшь", "волочёт", "волочём", "волочёте", "волокут"}},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Verb, func(t *testing.T) {
+			forms, err := GetConjugation(str.Word(tst.Verb))
+			require.NoError(t, err)
+			assert.Equal(t, tst.Forms, forms)
+
+			class, err := GetConjugationClass(str.Word(tst.Verb))
+			require.NoError(t, err)
+			assert.Equal(t, tst.Class, class)
+		})
+	}
+}
+
+func Test_Conjugate(t *testing.T) {
+	form, err := Conjugate(str.Word("получить"), Second, number.Plural)
+	require.NoError(t, err)
+	assert.Equal(t, "получите", form)
+
+	form, err = Conjugate(str.Word("получить"), Second, number.Singular)
+	require.NoError(t, err)
+	assert.Equal(t, "получишь", form)
+
+	_, err = Conjugate(str.Word("получить"), Person(4), number.Singular)
+	assert.Error(t, err)
+}
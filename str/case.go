@@ -0,0 +1,111 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+)
+
+/**
+ * Перенос регистра образца на слово: Москва - Москвы, НАСА - НАСА, iPhone-чехол - iPhone-чехла.
+ * Части через дефис обрабатываются по отдельности, если их число в образце и слове совпадает.
+ * @param string $pattern Образец (исходное слово)
+ * @param string $word Слово в нижнем регистре
+ * @return string
+ */
+func ApplyCase(pattern Word, s string) string {
+	patternParts := strings.Split(pattern.String(), "-")
+	parts := strings.Split(s, "-")
+	if len(patternParts) != len(parts) {
+		return applyPartCase([]rune(pattern), s)
+	}
+
+	for i, part := range parts {
+		parts[i] = applyPartCase([]rune(patternParts[i]), part)
+	}
+	return strings.Join(parts, "-")
+}
+
+/**
+ * Проверка, что в слове есть буквы и все они заглавные: НАСА, МГУ.
+ * @return bool
+ */
+func (w Word) IsUpper() bool {
+	upper, lower := countCases(w)
+	return upper > 0 && lower == 0
+}
+
+/**
+ * Проверка, что слово начинается с заглавной буквы, а остальные буквы строчные: Москва.
+ * @return bool
+ */
+func (w Word) IsTitle() bool {
+	if w.Empty() || !unicode.IsUpper(w[0]) {
+		return false
+	}
+	upper, _ := countCases(w[1:])
+	return upper == 0
+}
+
+/**
+ * Слово с заглавной первой буквой.
+ * @return string
+ */
+func (w Word) Title() Word {
+	if w.Empty() {
+		return w
+	}
+	return Word(string(unicode.ToUpper(w[0])) + string(w[1:]))
+}
+
+/**
+ * Перенос регистра образца на одну часть слова.
+ * @param string $pattern
+ * @param string $word
+ * @return string
+ */
+func applyPartCase(pattern []rune, s string) string {
+	w := Word(s)
+	upper, lower := countCases(pattern)
+	switch {
+	case upper == 0:
+		return s
+	case lower == 0 && upper > 1:
+		return w.Upper().String()
+	case Word(pattern).IsTitle() || lower == 0:
+		return w.Lower().Title().String()
+	}
+
+	// смешанный регистр переносится побуквенно, окончание - по последней букве образца
+	result := make([]rune, len(w))
+	tailUpper := unicode.IsUpper(pattern[len(pattern)-1])
+	for i, r := range w {
+		isUpper := tailUpper
+		if i < len(pattern) {
+			isUpper = unicode.IsUpper(pattern[i])
+		}
+		if isUpper {
+			result[i] = unicode.ToUpper(r)
+		} else {
+			result[i] = unicode.ToLower(r)
+		}
+	}
+	return string(result)
+}
+
+/**
+ * Подсчет заглавных и строчных букв.
+ * @param string $word
+ * @return int Заглавные
+ * @return int Строчные
+ */
+func countCases(w []rune) (upper, lower int) {
+	for _, r := range w {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		}
+	}
+	return upper, lower
+}
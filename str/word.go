@@ -76,6 +76,12 @@ func (w Word) SliceWord(from, till int) Word {
 	if till < 0 {
 		till = len + till
 	}
+	if from < 0 {
+		from = 0
+	}
+	if from > len {
+		from = len
+	}
 	if till < from {
 		till = from
 	}
@@ -91,6 +97,9 @@ func (w Word) SubWord(from int) Word {
 	if from < 0 {
 		from = len + from
 	}
+	if from < 0 {
+		from = 0
+	}
 	if from >= len {
 		return Word{}
 	}
@@ -22,3 +22,21 @@ func Test_Chars(t *testing.T) {
 	assert.Equal(t, "1234", a.Chars(0, -1))
 	assert.Equal(t, "12345", a.Chars(0, 1000))
 }
+
+func Test_ApplyCase(t *testing.T) {
+	assert.Equal(t, "москвы", ApplyCase(Word("москва"), "москвы"))
+	assert.Equal(t, "Москвы", ApplyCase(Word("Москва"), "москвы"))
+	assert.Equal(t, "НАСЫ", ApplyCase(Word("НАСА"), "насы"))
+	assert.Equal(t, "Я", ApplyCase(Word("Я"), "я"))
+	assert.Equal(t, "iPhone-чехла", ApplyCase(Word("iPhone-чехол"), "iphone-чехла"))
+	assert.Equal(t, "Диван-Кровати", ApplyCase(Word("Диван-Кровать"), "диван-кровати"))
+}
+
+func Test_Chars_OutOfRange(t *testing.T) {
+	a := Word("ня")
+
+	assert.Equal(t, "", a.Chars(-3, -2))
+	assert.Equal(t, "н", a.Chars(-5, -1))
+	assert.Equal(t, "", a.Chars(5, 10))
+	assert.Equal(t, "ня", string(a.SubWord(-5)))
+}